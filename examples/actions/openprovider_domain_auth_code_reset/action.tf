@@ -0,0 +1,7 @@
+# Reset the auth code with:
+#   terraform apply -invoke=action.openprovider_domain_auth_code_reset.example
+action "openprovider_domain_auth_code_reset" "example" {
+  config {
+    domain = "example.com"
+  }
+}
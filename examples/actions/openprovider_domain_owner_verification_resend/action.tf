@@ -0,0 +1,7 @@
+# Resend the verification email with:
+#   terraform apply -invoke=action.openprovider_domain_owner_verification_resend.example
+action "openprovider_domain_owner_verification_resend" "example" {
+  config {
+    domain = "example.com"
+  }
+}
@@ -0,0 +1,12 @@
+data "openprovider_domain" "by_id" {
+  domain_id = 123456
+}
+
+# Read delegation and DNSSEC data without managing the domain
+output "nameservers" {
+  value = data.openprovider_domain.by_id.name_servers[*].name
+}
+
+output "ds_keys" {
+  value = data.openprovider_domain.by_id.dnssec_keys
+}
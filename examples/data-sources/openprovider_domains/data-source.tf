@@ -0,0 +1,9 @@
+data "openprovider_domains" "expiring_nl" {
+  extension           = "nl"
+  status              = "ACT"
+  expires_within_days = 60
+}
+
+output "expiring_nl_domains" {
+  value = { for d in data.openprovider_domains.expiring_nl.domains : d.domain => d.expiration_date }
+}
@@ -0,0 +1,8 @@
+data "openprovider_ssl_approver_emails" "example" {
+  domain     = "example.com"
+  product_id = 1
+}
+
+output "approver_emails" {
+  value = data.openprovider_ssl_approver_emails.example.emails
+}
@@ -0,0 +1,16 @@
+# A certificate ordered outside Terraform, looked up by common name
+data "openprovider_ssl_order" "legacy" {
+  common_name = "legacy.example.com"
+}
+
+data "openprovider_ssl_order" "by_id" {
+  id = "123456"
+}
+
+output "legacy_certificate" {
+  value = {
+    status          = data.openprovider_ssl_order.legacy.status
+    expiration_date = data.openprovider_ssl_order.legacy.expiration_date
+    full_chain_pem  = data.openprovider_ssl_order.legacy.full_chain_pem
+  }
+}
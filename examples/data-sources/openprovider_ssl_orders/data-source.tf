@@ -0,0 +1,9 @@
+data "openprovider_ssl_orders" "expiring" {
+  status              = "ACT"
+  common_name_pattern = "*.example.com"
+  expires_within_days = 30
+}
+
+output "expiring_certificates" {
+  value = { for o in data.openprovider_ssl_orders.expiring.orders : o.common_name => o.expiration_date }
+}
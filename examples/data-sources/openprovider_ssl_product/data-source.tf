@@ -0,0 +1,11 @@
+data "openprovider_ssl_product" "by_id" {
+  product_id = 1
+}
+
+data "openprovider_ssl_product" "by_name" {
+  name = "PositiveSSL"
+}
+
+output "positivessl_prices" {
+  value = { for p in data.openprovider_ssl_product.by_name.prices : p.period => p.create_price }
+}
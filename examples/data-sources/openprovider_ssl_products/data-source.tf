@@ -0,0 +1,12 @@
+# Sectigo DV wildcard products that can be ordered for one year, cheapest first
+data "openprovider_ssl_products" "sectigo_wildcard" {
+  brand_name = "Sectigo"
+  category   = "dv"
+  wildcard   = true
+  period     = 1
+}
+
+resource "openprovider_ssl_order" "wildcard" {
+  product_id  = data.openprovider_ssl_products.sectigo_wildcard.products[0].product_id
+  common_name = "*.example.com"
+}
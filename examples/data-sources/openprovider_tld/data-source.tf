@@ -0,0 +1,11 @@
+data "openprovider_tld" "us" {
+  name = "us"
+}
+
+output "us_max_period" {
+  value = data.openprovider_tld.us.max_period
+}
+
+output "us_required_additional_data" {
+  value = data.openprovider_tld.us.required_additional_data
+}
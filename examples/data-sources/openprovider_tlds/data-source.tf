@@ -0,0 +1,7 @@
+data "openprovider_tlds" "candidates" {
+  names = ["com", "de", "eu", "us"]
+}
+
+output "dnssec_capable" {
+  value = [for tld in data.openprovider_tlds.candidates.tlds : tld.name if tld.dnssec_supported]
+}
@@ -0,0 +1,5 @@
+data "openprovider_unverified_domains" "all" {}
+
+output "suspended_domains" {
+  value = [for d in data.openprovider_unverified_domains.all.domains : d.domain if d.is_suspended]
+}
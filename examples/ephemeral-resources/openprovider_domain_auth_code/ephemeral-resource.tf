@@ -0,0 +1,12 @@
+ephemeral "openprovider_domain_auth_code" "example" {
+  domain = "example.com"
+}
+
+# Ephemeral values can only be used in ephemeral contexts, such as write-only
+# attributes. Here the auth code is handed to a secret store without being
+# written to Terraform state.
+resource "aws_secretsmanager_secret_version" "auth_code" {
+  secret_id                = "example-com-auth-code"
+  secret_string_wo         = ephemeral.openprovider_domain_auth_code.example.auth_code
+  secret_string_wo_version = 1
+}
@@ -0,0 +1,8 @@
+provider "openprovider" {
+  username = var.openprovider_username
+  password = var.openprovider_password
+
+  # Warn about domains expiring within 60 days, and fail the plan within 14 days
+  expiry_warning_days   = 60
+  fail_on_expiry_within = 14
+}
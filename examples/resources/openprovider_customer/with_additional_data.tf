@@ -0,0 +1,31 @@
+resource "openprovider_customer" "spanish_owner" {
+  email = "owner@example.es"
+
+  phone {
+    country_code = "34"
+    area_code    = "91"
+    number       = "1234567"
+  }
+
+  address {
+    street  = "Calle Mayor"
+    number  = "1"
+    city    = "Madrid"
+    zipcode = "28013"
+    country = "ES"
+  }
+
+  name {
+    first_name = "Juan"
+    last_name  = "Garcia"
+  }
+
+  additional_data {
+    social_security_number = var.owner_nif
+  }
+}
+
+variable "owner_nif" {
+  type      = string
+  sensitive = true
+}
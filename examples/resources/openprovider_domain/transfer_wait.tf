@@ -0,0 +1,22 @@
+# Transfer a domain and wait until the registry completes the transfer
+variable "auth_code" {
+  type        = string
+  sensitive   = true
+  description = "The authorization code from your current registrar"
+}
+
+resource "openprovider_domain" "transferred" {
+  domain            = "example.com"
+  auth_code         = var.auth_code
+  owner_handle      = "owner123"
+  autorenew         = true
+  wait_for_transfer = true
+
+  timeouts {
+    create = "2h"
+  }
+}
+
+output "transfer_status" {
+  value = openprovider_domain.transferred.transfer_status
+}
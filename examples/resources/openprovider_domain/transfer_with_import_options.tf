@@ -1,4 +1,5 @@
-# Transfer a domain with multiple contact handles
+# Transfer a domain with multiple contact handles, keeping the current nameservers
+# and importing the existing DNS zone into OpenProvider
 variable "auth_code" {
   type        = string
   sensitive   = true
@@ -53,4 +54,7 @@ resource "openprovider_domain" "transferred" {
   tech_handle    = openprovider_customer.admin.handle
   billing_handle = openprovider_customer.admin.handle
   autorenew      = true
+
+  import_nameservers_from_registry = true
+  import_dns_zone                  = true
 }
@@ -0,0 +1,17 @@
+# Transfer a domain and point it at new nameservers as part of the transfer
+variable "auth_code" {
+  type        = string
+  sensitive   = true
+  description = "The authorization code from your current registrar"
+}
+
+resource "openprovider_domain" "transferred" {
+  domain       = "example.com"
+  auth_code    = var.auth_code
+  owner_handle = "owner123"
+
+  transfer_nameservers = [
+    { name = "ns1.example.net" },
+    { name = "ns2.example.net" },
+  ]
+}
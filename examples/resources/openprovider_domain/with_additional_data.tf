@@ -0,0 +1,20 @@
+resource "openprovider_domain" "us" {
+  domain       = "example.us"
+  owner_handle = "owner123"
+  period       = 1
+
+  additional_data = {
+    nexus_category      = "C11"
+    application_purpose = "P1"
+  }
+}
+
+resource "openprovider_domain" "de" {
+  domain       = "example.de"
+  owner_handle = "owner123"
+  period       = 1
+
+  additional_data = {
+    trustee_service = true
+  }
+}
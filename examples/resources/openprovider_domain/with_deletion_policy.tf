@@ -0,0 +1,15 @@
+# Test stack: allow `terraform destroy` to delete the domain while it is still
+# within the add-grace period, so the registration fee is refunded.
+resource "openprovider_domain" "sandbox" {
+  domain          = "example-sandbox.com"
+  owner_handle    = "owner123"
+  period          = 1
+  deletion_policy = "delete"
+}
+
+# Stop managing a domain without touching the registration.
+resource "openprovider_domain" "legacy" {
+  domain          = "example-legacy.com"
+  owner_handle    = "owner123"
+  deletion_policy = "abandon"
+}
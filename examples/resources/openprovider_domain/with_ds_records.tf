@@ -4,8 +4,8 @@ resource "openprovider_domain" "dnssec" {
   period       = 1
   autorenew    = true
 
-  # Optional DS records for DNSSEC
-  ds_records = [
+  # DNSKEY records to publish at the registry for DNSSEC
+  dnssec_keys = [
     {
       algorithm  = 8
       flags      = 257
@@ -0,0 +1,13 @@
+resource "openprovider_domain" "signed" {
+  domain       = "mydomain.com"
+  owner_handle = "owner123"
+  ns_group     = "openprovider-dns"
+  autorenew    = true
+
+  # Sign the zone on OpenProvider DNS and publish its keys at the registry
+  managed_dnssec = true
+}
+
+output "ds_records" {
+  value = openprovider_domain.signed.ds_records
+}
@@ -0,0 +1,9 @@
+resource "openprovider_domain" "example" {
+  domain       = "example.eu"
+  owner_handle = "newowner456"
+  autorenew    = true
+
+  # Changing owner_handle is rejected at plan time unless explicitly allowed.
+  # Registries that require a trade charge a trade fee, shown during `terraform plan`.
+  allow_owner_change = true
+}
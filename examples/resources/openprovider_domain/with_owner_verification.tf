@@ -0,0 +1,14 @@
+resource "openprovider_domain" "example" {
+  domain       = "example.com"
+  owner_handle = "owner123"
+  autorenew    = true
+
+  lifecycle {
+    # Fail the run instead of leaving the domain suspended when the owner
+    # does not verify their email address.
+    postcondition {
+      condition     = contains(["verified", "in_progress", "none"], self.owner_verification_status)
+      error_message = "The owner of ${self.domain} has not verified their email address (${self.owner_verification_status})."
+    }
+  }
+}
@@ -0,0 +1,6 @@
+resource "openprovider_domain" "example" {
+  domain       = "example.com"
+  owner_handle = "owner123"
+  period       = 1
+  is_locked    = true
+}
@@ -0,0 +1,9 @@
+resource "openprovider_domain" "example" {
+  domain       = "example.com"
+  owner_handle = "owner123"
+  autorenew    = true
+
+  # Restore the domain from redemption if it ever expires or is deleted.
+  # The restore fee is shown as a warning during `terraform plan`.
+  restore_if_expired = true
+}
@@ -0,0 +1,10 @@
+resource "openprovider_domain" "example" {
+  domain        = "example.com"
+  owner_handle  = "owner123"
+  period        = 1
+  whois_privacy = true
+}
+
+output "whois_privacy_status" {
+  value = openprovider_domain.example.whois_privacy_status
+}
@@ -0,0 +1,15 @@
+resource "openprovider_ssl_csr" "example" {
+  common_name        = "example.com"
+  additional_domains = ["www.example.com"]
+  key_algorithm      = "ECDSA"
+  ecdsa_curve        = "P256"
+  organization       = "Example B.V."
+  country            = "NL"
+}
+
+resource "openprovider_ssl_order" "example" {
+  product_id         = 1
+  common_name        = openprovider_ssl_csr.example.common_name
+  additional_domains = openprovider_ssl_csr.example.additional_domains
+  csr                = openprovider_ssl_csr.example.csr_pem
+}
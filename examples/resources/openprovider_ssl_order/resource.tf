@@ -0,0 +1,30 @@
+resource "openprovider_ssl_order" "example" {
+  product_id         = 1
+  common_name        = "example.com"
+  additional_domains = ["www.example.com"]
+  autorenew          = true
+
+  # Wait until the certificate is issued, so dependent resources get a real certificate
+  wait_for_issuance = true
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+resource "kubernetes_secret_v1" "tls" {
+  metadata {
+    name = "example-com-tls"
+  }
+
+  type = "kubernetes.io/tls"
+
+  data = {
+    "tls.crt" = openprovider_ssl_order.example.full_chain_pem
+    "tls.key" = var.private_key_pem
+  }
+}
+
+output "certificate_not_after" {
+  value = openprovider_ssl_order.example.not_after
+}
@@ -0,0 +1,10 @@
+# The zone of example.com is hosted on OpenProvider DNS, so the provider publishes
+# the DCV records itself and removes them once the certificate has been issued.
+resource "openprovider_ssl_order" "example" {
+  product_id               = 1
+  common_name              = "example.com"
+  additional_domains       = ["www.example.com"]
+  domain_validation_method = "dns"
+  auto_dns_validation      = true
+  wait_for_issuance        = true
+}
@@ -0,0 +1,7 @@
+# A short-lived test certificate that is canceled on destroy while it can still
+# be refunded
+resource "openprovider_ssl_order" "test" {
+  product_id      = 1
+  common_name     = "test.example.com"
+  deletion_policy = "cancel_if_refundable"
+}
@@ -0,0 +1,7 @@
+# List the allowed addresses with the openprovider_ssl_approver_emails data source
+resource "openprovider_ssl_order" "email_validated" {
+  product_id               = 1
+  common_name              = "example.com"
+  domain_validation_method = "email"
+  approver_email           = "admin@example.com"
+}
@@ -0,0 +1,16 @@
+# For zones hosted elsewhere, create the DCV records from dcv_records yourself
+resource "openprovider_ssl_order" "example" {
+  product_id               = 1
+  common_name              = "example.org"
+  domain_validation_method = "dns"
+}
+
+resource "aws_route53_record" "dcv" {
+  for_each = { for record in openprovider_ssl_order.example.dcv_records : record.name => record }
+
+  zone_id = var.route53_zone_id
+  name    = each.value.name
+  type    = each.value.type
+  ttl     = 600
+  records = [each.value.value]
+}
@@ -0,0 +1,14 @@
+resource "openprovider_ssl_order" "example" {
+  product_id  = 1
+  common_name = "example.com"
+
+  # Renew for one year once the certificate expires within 30 days, and wait
+  # for the renewed certificate during the apply
+  renew_before_days = 30
+  renewal_period    = 1
+  wait_for_issuance = true
+
+  timeouts {
+    update = "30m"
+  }
+}
@@ -19,6 +19,8 @@ type CreateCustomerRequest struct {
 	Name        Name    `json:"name"`
 	Locale      string  `json:"locale,omitempty"`
 	Comments    string  `json:"comments,omitempty"`
+
+	AdditionalData *AdditionalData `json:"additional_data,omitempty"`
 }
 
 // CreateCustomerResponse represents a response for creating a customer.
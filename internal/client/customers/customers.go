@@ -35,6 +35,16 @@ type Phone struct {
 	Number      string `json:"subscriber_number"`
 }
 
+// AdditionalData represents registry-specific customer data, such as the
+// identification numbers required by the .es and .it registries. Empty values
+// are sent, so that an update clears them.
+type AdditionalData struct {
+	BirthDate                 string `json:"birth_date"`
+	CompanyRegistrationNumber string `json:"company_registration_number"`
+	PassportNumber            string `json:"passport_number"`
+	SocialSecurityNumber      string `json:"social_security_number"`
+}
+
 // Customer represents a customer entity.
 type Customer struct {
 	ID          int     `json:"id"`
@@ -46,6 +56,8 @@ type Customer struct {
 	Name        Name    `json:"name"`
 	Locale      string  `json:"locale,omitempty"`
 	Comments    string  `json:"comments,omitempty"`
+
+	AdditionalData *AdditionalData `json:"additional_data,omitempty"`
 }
 
 // ListCustomersResponse represents a response from the customers listing endpoint.
@@ -19,6 +19,8 @@ type UpdateCustomerRequest struct {
 	Name        *Name    `json:"name,omitempty"`
 	Locale      string   `json:"locale,omitempty"`
 	Comments    string   `json:"comments,omitempty"`
+
+	AdditionalData *AdditionalData `json:"additional_data,omitempty"`
 }
 
 // UpdateCustomerResponse represents a response for updating a customer.
@@ -0,0 +1,189 @@
+// Package customers provides functionality for working with customers.
+package customers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+const (
+	// VerificationStatusVerified indicates the registrant has verified their email address.
+	VerificationStatusVerified = "verified"
+	// VerificationStatusInProgress indicates a verification email has been sent and is awaiting confirmation.
+	VerificationStatusInProgress = "in progress"
+	// VerificationStatusFailed indicates the verification period expired without confirmation.
+	VerificationStatusFailed = "failed"
+	// VerificationStatusNotVerified indicates no verification has been completed yet.
+	VerificationStatusNotVerified = "not verified"
+)
+
+// EmailVerification represents the ICANN registrant email verification of a domain.
+// Domains whose registrant does not verify in time are suspended by the registry.
+type EmailVerification struct {
+	Domain         string `json:"domain"`
+	Email          string `json:"email"`
+	Status         string `json:"status"`
+	IsSuspended    bool   `json:"is_suspended"`
+	ExpirationDate string `json:"expiration_date,omitempty"`
+}
+
+// ListEmailVerificationsResponse represents a response from the email verification listing endpoint.
+type ListEmailVerificationsResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Results []EmailVerification `json:"results"`
+		Total   int                 `json:"total"`
+	} `json:"data"`
+}
+
+// verificationsPageSize is the number of verifications requested per page when
+// listing email verifications.
+const verificationsPageSize = 100
+
+// ListEmailVerifications retrieves the registrant email verifications of domains,
+// requesting further pages until every verification has been returned. When email
+// is set, only the verifications for that address are returned.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/customers/verifications/emails/domains
+func ListEmailVerifications(c *client.Client, email string) ([]EmailVerification, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(verificationsPageSize))
+	if email != "" {
+		query.Set("email", email)
+	}
+
+	var all []EmailVerification
+	for offset := 0; ; offset += verificationsPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+
+		path := "/v1beta/customers/verifications/emails/domains?" + query.Encode()
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.Do(req)
+		if err != nil {
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			return nil, err
+		}
+
+		var result ListEmailVerificationsResponse
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if result.Code != 0 {
+			return nil, fmt.Errorf("email verification listing failed with code %d", result.Code)
+		}
+
+		all = append(all, result.Data.Results...)
+
+		// Stop on a short page, or once the reported total has been reached
+		if len(result.Data.Results) < verificationsPageSize || (result.Data.Total > 0 && len(all) >= result.Data.Total) {
+			return all, nil
+		}
+	}
+}
+
+// GetDomainEmailVerification retrieves the registrant email verification of a
+// single domain. It returns (nil, nil) when no verification is recorded, which is
+// the case for registrants that were verified before or TLDs that do not require it.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/customers/verifications/emails/domains
+func GetDomainEmailVerification(c *client.Client, domainName string) (*EmailVerification, error) {
+	query := url.Values{}
+	query.Set("domain", domainName)
+
+	path := "/v1beta/customers/verifications/emails/domains?" + query.Encode()
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListEmailVerificationsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if result.Code != 0 {
+		return nil, fmt.Errorf("email verification retrieval failed with code %d", result.Code)
+	}
+
+	for _, verification := range result.Data.Results {
+		if verification.Domain == domainName {
+			return &verification, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// RestartEmailVerificationRequest represents a request to resend the verification email.
+type RestartEmailVerificationRequest struct {
+	Email string `json:"email"`
+}
+
+// RestartEmailVerificationResponse represents a response for resending the verification email.
+type RestartEmailVerificationResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Success bool `json:"success"`
+	} `json:"data"`
+}
+
+// RestartEmailVerification resends the registrant verification email to the
+// given address and restarts the verification period.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/customers/verifications/emails/restart
+func RestartEmailVerification(c *client.Client, email string) error {
+	body, err := json.Marshal(&RestartEmailVerificationRequest{Email: email})
+	if err != nil {
+		return err
+	}
+
+	path := "/v1beta/customers/verifications/emails/restart"
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(req)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	var result RestartEmailVerificationResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	if result.Code != 0 {
+		return fmt.Errorf("email verification restart failed with code %d", result.Code)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Package customers_test contains tests for the customers package.
+package customers_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
+	"github.com/charpand/terraform-provider-openprovider/internal/testutils"
+)
+
+func TestListEmailVerifications(t *testing.T) {
+	apiClient := testutils.SetupTestClient()
+
+	results, err := customers.ListEmailVerifications(apiClient, "owner@example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	t.Logf("Retrieved %d email verifications", len(results))
+}
+
+func TestListEmailVerificationsPaginates(t *testing.T) {
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+
+		// A full page of 100 followed by a short page of 2
+		count := 100
+		if offset >= 100 {
+			count = 2
+		}
+		results := ""
+		for i := 0; i < count; i++ {
+			if i > 0 {
+				results += ","
+			}
+			results += fmt.Sprintf(`{"domain": "example%d.com", "status": "in progress"}`, offset+i)
+		}
+		_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"results": [%s], "total": 102}}`, results)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	results, err := customers.ListEmailVerifications(apiClient, "owner@example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(results) != 102 {
+		t.Errorf("Expected 102 verifications, got %d", len(results))
+	}
+	if len(offsets) != 2 || offsets[0] != "0" || offsets[1] != "100" {
+		t.Errorf("Expected offsets [0 100], got %v", offsets)
+	}
+}
+
+func TestGetDomainEmailVerification(t *testing.T) {
+	var requestedDomain string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedDomain = r.URL.Query().Get("domain")
+		_, _ = fmt.Fprint(w, `{"code": 0, "data": {"results": [
+			{"domain": "example.com", "email": "owner@example.com", "status": "in progress", "is_suspended": true}
+		]}}`)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	verification, err := customers.GetDomainEmailVerification(apiClient, "example.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requestedDomain != "example.com" {
+		t.Errorf("Expected domain filter example.com, got %s", requestedDomain)
+	}
+	if verification == nil || verification.Status != customers.VerificationStatusInProgress || !verification.IsSuspended {
+		t.Errorf("Unexpected verification: %+v", verification)
+	}
+
+	verification, err = customers.GetDomainEmailVerification(apiClient, "other.com")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if verification != nil {
+		t.Errorf("Expected no verification for other.com, got %+v", verification)
+	}
+}
+
+func TestRestartEmailVerification(t *testing.T) {
+	apiClient := testutils.SetupTestClient()
+
+	err := customers.RestartEmailVerification(apiClient, "owner@example.com")
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestRestartEmailVerificationErrorCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"code": 399, "desc": "Verification cannot be restarted"}`)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	if err := customers.RestartEmailVerification(apiClient, "owner@example.com"); err == nil {
+		t.Error("Expected an error for a non-zero response code")
+	}
+}
@@ -19,11 +19,21 @@ type Record struct {
 
 // Zone represents a DNS zone.
 type Zone struct {
-	Name             string `json:"name"`
-	Extension        string `json:"extension"`
-	Type             string `json:"type,omitempty"`
-	CreationDate     string `json:"creation_date,omitempty"`
-	ModificationDate string `json:"modification_date,omitempty"`
+	Name             string      `json:"name"`
+	Extension        string      `json:"extension"`
+	Type             string      `json:"type,omitempty"`
+	CreationDate     string      `json:"creation_date,omitempty"`
+	ModificationDate string      `json:"modification_date,omitempty"`
+	IsDnssecEnabled  bool        `json:"is_dnssec_enabled,omitempty"`
+	DnssecKeys       []DnssecKey `json:"dnssec_keys,omitempty"`
+}
+
+// DnssecKey represents a DNSKEY record of a zone signed by OpenProvider.
+type DnssecKey struct {
+	Alg      int    `json:"alg"`
+	Flags    int    `json:"flags"`
+	Protocol int    `json:"protocol"`
+	PubKey   string `json:"pub_key"`
 }
 
 // ListRecordsResponse represents the API response for listing DNS records.
@@ -100,6 +110,19 @@ type GetZoneResponse struct {
 	Data Zone `json:"data"`
 }
 
+// UpdateZoneRequest represents a request to update the settings of a DNS zone.
+type UpdateZoneRequest struct {
+	IsDnssecEnabled *bool `json:"is_dnssec_enabled,omitempty"`
+}
+
+// UpdateZoneResponse represents the API response for updating a DNS zone.
+type UpdateZoneResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Success bool `json:"success"`
+	} `json:"data"`
+}
+
 // RecordUpdates represents record updates for a zone.
 type RecordUpdates struct {
 	Add     []Record `json:"add,omitempty"`
@@ -0,0 +1,104 @@
+// Package dns provides functionality for working with DNS records and zones.
+package dns
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// DigestTypeSHA256 is the DS digest type for SHA-256 (RFC 4509).
+const DigestTypeSHA256 = 2
+
+// FlagsKSK is the DNSKEY flags value of a key signing key.
+const FlagsKSK = 257
+
+// DSRecord represents a DS record derived from a DNSKEY record.
+type DSRecord struct {
+	KeyTag     int
+	Algorithm  int
+	DigestType int
+	Digest     string
+}
+
+// KeyTag calculates the key tag of a DNSKEY record (RFC 4034, Appendix B).
+func KeyTag(key DnssecKey) (int, error) {
+	rdata, err := dnskeyRdata(key)
+	if err != nil {
+		return 0, err
+	}
+
+	var ac uint32
+	for i, b := range rdata {
+		if i&1 == 1 {
+			ac += uint32(b)
+		} else {
+			ac += uint32(b) << 8
+		}
+	}
+	ac += ac >> 16 & 0xFFFF
+
+	return int(ac & 0xFFFF), nil
+}
+
+// ComputeDS calculates the SHA-256 DS record for a DNSKEY record of the given zone
+// (RFC 4509).
+func ComputeDS(zoneName string, key DnssecKey) (*DSRecord, error) {
+	owner, err := canonicalName(zoneName)
+	if err != nil {
+		return nil, err
+	}
+
+	rdata, err := dnskeyRdata(key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyTag, err := KeyTag(key)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(append(owner, rdata...))
+
+	return &DSRecord{
+		KeyTag:     keyTag,
+		Algorithm:  key.Alg,
+		DigestType: DigestTypeSHA256,
+		Digest:     strings.ToUpper(hex.EncodeToString(digest[:])),
+	}, nil
+}
+
+// dnskeyRdata returns the wire format RDATA of a DNSKEY record.
+func dnskeyRdata(key DnssecKey) ([]byte, error) {
+	pubKey, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(key.PubKey), ""))
+	if err != nil {
+		return nil, fmt.Errorf("invalid DNSKEY public key: %w", err)
+	}
+
+	rdata := make([]byte, 4, 4+len(pubKey))
+	binary.BigEndian.PutUint16(rdata, uint16(key.Flags))
+	rdata[2] = byte(key.Protocol)
+	rdata[3] = byte(key.Alg)
+
+	return append(rdata, pubKey...), nil
+}
+
+// canonicalName returns the lowercase wire format of a domain name.
+func canonicalName(name string) ([]byte, error) {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+
+	var wire []byte
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 {
+			return nil, fmt.Errorf("invalid domain name %q", name)
+		}
+		wire = append(wire, byte(len(label)))
+		wire = append(wire, label...)
+	}
+
+	return append(wire, 0), nil
+}
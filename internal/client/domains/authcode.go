@@ -0,0 +1,88 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// AuthCode represents the authorization (EPP) code of a domain.
+type AuthCode struct {
+	AuthCode string `json:"auth_code"`
+	Type     string `json:"type,omitempty"`
+}
+
+// AuthCodeResponse represents a response for retrieving or resetting an auth code.
+type AuthCodeResponse struct {
+	Code int      `json:"code"`
+	Data AuthCode `json:"data"`
+}
+
+// GetAuthCode retrieves the current authorization code of a domain, which is
+// needed to transfer the domain to another registrar.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/domains/{id}/authcode
+func GetAuthCode(c *client.Client, id int) (*AuthCode, error) {
+	path := fmt.Sprintf("/v1beta/domains/%d/authcode", id)
+	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(httpReq)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result AuthCodeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != 0 {
+		return nil, fmt.Errorf("auth code retrieval failed with code %d", result.Code)
+	}
+
+	return &result.Data, nil
+}
+
+// ResetAuthCode generates a new authorization code for a domain. The previous
+// code is invalidated at the registry.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/domains/{id}/authcode/reset
+func ResetAuthCode(c *client.Client, id int) (*AuthCode, error) {
+	path := fmt.Sprintf("/v1beta/domains/%d/authcode/reset", id)
+	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(httpReq)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result AuthCodeResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != 0 {
+		return nil, fmt.Errorf("auth code reset failed with code %d", result.Code)
+	}
+
+	return &result.Data, nil
+}
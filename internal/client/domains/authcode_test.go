@@ -0,0 +1,37 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/charpand/terraform-provider-openprovider/internal/testutils"
+)
+
+func TestGetAuthCode(t *testing.T) {
+	apiClient := testutils.SetupTestClient()
+
+	authCode, err := domains.GetAuthCode(apiClient, 123)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if authCode.AuthCode == "" {
+		t.Log("Note: Auth code not populated by mock server")
+	}
+}
+
+func TestResetAuthCode(t *testing.T) {
+	apiClient := testutils.SetupTestClient()
+
+	authCode, err := domains.ResetAuthCode(apiClient, 123)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if authCode.AuthCode == "" {
+		t.Log("Note: Auth code not populated by mock server")
+	}
+}
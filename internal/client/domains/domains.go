@@ -5,10 +5,33 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 )
 
+// Domain status codes returned by the Openprovider API.
+const (
+	// StatusActive indicates the domain is registered or the transfer has completed.
+	StatusActive = "ACT"
+	// StatusRequested indicates a registration or transfer has been requested.
+	StatusRequested = "REQ"
+	// StatusPending indicates the registry has not yet processed the request.
+	StatusPending = "PEN"
+	// StatusFailed indicates the registration or transfer failed.
+	StatusFailed = "FAI"
+	// StatusRejected indicates the transfer was rejected by the losing registrar or registrant.
+	StatusRejected = "REJ"
+	// StatusExpired indicates the domain has expired and is in the redemption period.
+	StatusExpired = "EXP"
+	// StatusDeleted indicates the domain has been deleted and is in redemption or quarantine at the registry.
+	StatusDeleted = "DEL"
+	// StatusRestoreRequested indicates a restore from redemption has been requested.
+	StatusRestoreRequested = "RRQ"
+)
+
 // Nameserver represents a domain nameserver.
 type Nameserver struct {
 	Name  string `json:"name"`
@@ -28,29 +51,56 @@ type DnssecKey struct {
 	Readonly int `json:"readonly,omitempty"`
 }
 
+// AdditionalData represents registry-specific domain data required to register
+// domains under some extensions. Empty values are sent, so that an update clears
+// them.
+type AdditionalData struct {
+	// UseDomicile enables OpenProvider's trustee (local presence) service for
+	// registries that require a local contact, such as .de, .eu and .it. It is a
+	// pointer so that an explicit false is sent and reported.
+	UseDomicile *bool `json:"use_domicile,omitempty"`
+	// NexusCategory is the .us nexus category of the registrant (C11, C12, C21, C31 or C32).
+	NexusCategory string `json:"nexus_category"`
+	// ApplicationPurpose is the .us application purpose (P1 to P5).
+	ApplicationPurpose string `json:"application_purpose"`
+	// LegalType is the CIRA legal type of the .ca registrant (e.g. CCT, CCO).
+	LegalType string `json:"legal_type"`
+	// EntityType is the .it registrant entity type (1 to 7).
+	EntityType string `json:"entity_type"`
+	// IDNumber is the registrant identification number, such as the NIF/NIE
+	// for .es or the codice fiscale for .it.
+	IDNumber string `json:"id_number"`
+}
+
 // Domain represents a domain entity.
 type Domain struct {
-	ID              int          `json:"id"`
-	ActiveDate      string       `json:"active_date"`
-	AdminHandle     string       `json:"admin_handle"`
-	AuthCode        string       `json:"auth_code"`
-	Autorenew       string       `json:"autorenew"`
-	BillingHandle   string       `json:"billing_handle"`
-	CanRenew        bool         `json:"can_renew"`
-	CreationDate    string       `json:"creation_date"`
-	ExpirationDate  string       `json:"expiration_date"`
-	IsAbusive       bool         `json:"is_abusive"`
-	IsLocked        bool         `json:"is_locked"`
-	LastChanged     string       `json:"last_changed"`
-	OrderDate       string       `json:"order_date"`
-	OwnerHandle     string       `json:"owner_handle"`
-	Status          string       `json:"status"`
-	TechHandle      string       `json:"tech_handle"`
-	Nameservers     []Nameserver `json:"name_servers,omitempty"`
-	NSGroup         string       `json:"ns_group,omitempty"`
-	DnssecKeys      []DnssecKey  `json:"dnssec_keys,omitempty"`
-	IsDnssecEnabled bool         `json:"is_dnssec_enabled,omitempty"`
-	Domain          struct {
+	ID                    int             `json:"id"`
+	ActiveDate            string          `json:"active_date"`
+	AdminHandle           string          `json:"admin_handle"`
+	AuthCode              string          `json:"auth_code"`
+	Autorenew             string          `json:"autorenew"`
+	BillingHandle         string          `json:"billing_handle"`
+	CanRenew              bool            `json:"can_renew"`
+	CreationDate          string          `json:"creation_date"`
+	ExpirationDate        string          `json:"expiration_date"`
+	IsAbusive             bool            `json:"is_abusive"`
+	IsLocked              bool            `json:"is_locked"`
+	IsLockable            bool            `json:"is_lockable"`
+	IsPrivateWhoisAllowed bool            `json:"is_private_whois_allowed"`
+	IsPrivateWhoisEnabled bool            `json:"is_private_whois_enabled"`
+	LastChanged           string          `json:"last_changed"`
+	OrderDate             string          `json:"order_date"`
+	OwnerHandle           string          `json:"owner_handle"`
+	Status                string          `json:"status"`
+	TechHandle            string          `json:"tech_handle"`
+	Nameservers           []Nameserver    `json:"name_servers,omitempty"`
+	NSGroup               string          `json:"ns_group,omitempty"`
+	DnssecKeys            []DnssecKey     `json:"dnssec_keys,omitempty"`
+	IsDnssecEnabled       bool            `json:"is_dnssec_enabled,omitempty"`
+	ApproverEmail         string          `json:"approver_email,omitempty"`
+	StatusDescription     string          `json:"status_description,omitempty"`
+	AdditionalData        *AdditionalData `json:"additional_data,omitempty"`
+	Domain                struct {
 		Name      string `json:"name"`
 		Extension string `json:"extension"`
 	} `json:"domain"`
@@ -65,26 +115,83 @@ type ListDomainsResponse struct {
 	} `json:"data"`
 }
 
-// List retrieves a list of domains from the Openprovider API.
-func List(c *client.Client) ([]Domain, error) {
-	path := "/v1beta/domains"
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
-	if err != nil {
-		return nil, err
+// listPageSize is the number of domains requested per page when listing domains.
+const listPageSize = 100
+
+// ListDomainsOptions filters the domains returned by List. Empty fields are not
+// sent, so a nil or zero value lists all domains.
+type ListDomainsOptions struct {
+	// Extension only returns domains under this extension (e.g., "nl").
+	Extension string
+	// Status only returns domains with this status (e.g., StatusActive).
+	Status string
+	// NamePattern only returns domains whose name, without extension, matches
+	// this pattern. The wildcard "*" is supported.
+	NamePattern string
+	// OwnerHandle only returns domains that use this contact handle in any role:
+	// owner, admin, tech or billing.
+	OwnerHandle string
+	// NSGroup only returns domains that use this nameserver group.
+	NSGroup string
+}
+
+// List retrieves all domains matching the options from the Openprovider API,
+// requesting further pages until every matching domain has been returned.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/domains
+func List(c *client.Client, opts *ListDomainsOptions) ([]Domain, error) {
+	if opts == nil {
+		opts = &ListDomainsOptions{}
 	}
 
-	resp, err := c.Do(req)
-	if err != nil {
-		return nil, err
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(listPageSize))
+	if opts.Extension != "" {
+		query.Set("extension", strings.TrimPrefix(opts.Extension, "."))
+	}
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
 	}
+	if opts.NamePattern != "" {
+		query.Set("domain_name_pattern", opts.NamePattern)
+	}
+	if opts.OwnerHandle != "" {
+		query.Set("contact_handle", opts.OwnerHandle)
+	}
+	if opts.NSGroup != "" {
+		query.Set("ns_group_pattern", opts.NSGroup)
+	}
+
+	var all []Domain
+	for offset := 0; ; offset += listPageSize {
+		query.Set("offset", strconv.Itoa(offset))
 
-	defer func() {
+		path := "/v1beta/domains?" + query.Encode()
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.Do(req)
+		if err != nil {
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			return nil, err
+		}
+
+		var results ListDomainsResponse
+		err = json.NewDecoder(resp.Body).Decode(&results)
 		_ = resp.Body.Close()
-	}()
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, results.Data.Results...)
 
-	var results ListDomainsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
-		return nil, err
+		// Stop on a short page, or once the reported total has been reached
+		if len(results.Data.Results) < listPageSize || (results.Data.Total > 0 && len(all) >= results.Data.Total) {
+			return all, nil
+		}
 	}
-	return results.Data.Results, nil
 }
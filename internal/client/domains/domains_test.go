@@ -2,8 +2,14 @@
 package domains_test
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
 	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
 	"github.com/charpand/terraform-provider-openprovider/internal/testutils"
 )
@@ -11,7 +17,7 @@ import (
 func TestListDomains(t *testing.T) {
 	apiClient := testutils.SetupTestClient()
 
-	resp, err := domains.List(apiClient)
+	resp, err := domains.List(apiClient, nil)
 
 	if err != nil {
 		t.Fatalf("Expected no error, got %v", err)
@@ -21,3 +27,44 @@ func TestListDomains(t *testing.T) {
 		t.Log("Note: No domains returned by mock server (check your swagger examples)")
 	}
 }
+
+func TestListDomainsPaginatesWithFilters(t *testing.T) {
+	const total = 250
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		results := make([]string, 0, limit)
+		for i := offset; i < offset+limit && i < total; i++ {
+			results = append(results, fmt.Sprintf(`{"id": %d, "domain": {"name": "example%d", "extension": "nl"}}`, i+1, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"results": [%s], "total": %d}}`, strings.Join(results, ","), total)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	results, err := domains.List(apiClient, &domains.ListDomainsOptions{
+		Extension:   ".nl",
+		Status:      domains.StatusActive,
+		OwnerHandle: "owner123",
+		NSGroup:     "dns-openprovider",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(results) != total {
+		t.Errorf("Expected %d domains across all pages, got %d", total, len(results))
+	}
+	if len(queries) != 3 {
+		t.Errorf("Expected 3 page requests, got %d", len(queries))
+	}
+	for _, param := range []string{"extension=nl", "status=ACT", "contact_handle=owner123", "ns_group_pattern=dns-openprovider"} {
+		if !strings.Contains(queries[0], param) {
+			t.Errorf("Expected query %q to contain %s", queries[0], param)
+		}
+	}
+}
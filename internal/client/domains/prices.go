@@ -0,0 +1,75 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// Domain operations that can be priced.
+const (
+	OperationCreate   = "create"
+	OperationRenew    = "renew"
+	OperationTransfer = "transfer"
+	OperationRestore  = "restore"
+	OperationTrade    = "trade"
+)
+
+// Price represents an amount in a currency.
+type Price struct {
+	Currency string  `json:"currency"`
+	Price    float64 `json:"price"`
+}
+
+// DomainPrice represents the price of an operation on a domain.
+type DomainPrice struct {
+	IsPromotion bool `json:"is_promotion"`
+	Price       struct {
+		Product  Price `json:"product"`
+		Reseller Price `json:"reseller"`
+	} `json:"price"`
+}
+
+// GetDomainPriceResponse represents a response for a domain price.
+type GetDomainPriceResponse struct {
+	Code int         `json:"code"`
+	Data DomainPrice `json:"data"`
+}
+
+// GetPrice retrieves the price of an operation (create, renew, transfer, restore or
+// trade) on a domain.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/domains/prices
+func GetPrice(c *client.Client, name, extension, operation string) (*DomainPrice, error) {
+	query := url.Values{}
+	query.Set("domain.name", name)
+	query.Set("domain.extension", extension)
+	query.Set("operation", operation)
+
+	path := "/v1beta/domains/prices?" + query.Encode()
+	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(httpReq)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result GetDomainPriceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &result.Data, nil
+}
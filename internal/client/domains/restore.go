@@ -0,0 +1,52 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// RestoreDomainResponse represents a response for restoring a domain.
+type RestoreDomainResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Status string `json:"status"`
+	} `json:"data"`
+}
+
+// Restore requests the restore of an expired or deleted domain that is in the
+// redemption period. Restores are charged at the registry's restore fee. It
+// returns the domain status reported after the request.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/domains/{id}/restore
+func Restore(c *client.Client, id int) (string, error) {
+	path := fmt.Sprintf("/v1beta/domains/%d/restore", id)
+	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.Do(httpReq)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var result RestoreDomainResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+
+	if result.Code != 0 {
+		return "", fmt.Errorf("restore failed with code %d", result.Code)
+	}
+
+	return result.Data.Status, nil
+}
@@ -0,0 +1,42 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/charpand/terraform-provider-openprovider/internal/testutils"
+)
+
+func TestRestoreDomain(t *testing.T) {
+	apiClient := testutils.SetupTestClient()
+
+	status, err := domains.Restore(apiClient, 123)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if status == "" {
+		t.Log("Note: No status returned by mock server")
+	}
+}
+
+func TestGetDomainPrice(t *testing.T) {
+	apiClient := testutils.SetupTestClient()
+
+	price, err := domains.GetPrice(apiClient, "example", "com", domains.OperationRestore)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if price == nil {
+		t.Log("Note: No price returned by mock server (check your swagger examples)")
+		return
+	}
+
+	if price.Price.Reseller.Currency == "" {
+		t.Log("Note: Reseller price not populated by mock server")
+	}
+}
@@ -0,0 +1,70 @@
+// Package domains provides functionality for working with domains.
+package domains
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// TradeDomainRequest represents a request to trade a domain to a new owner.
+type TradeDomainRequest struct {
+	Domain struct {
+		Name      string `json:"name"`
+		Extension string `json:"extension"`
+	} `json:"domain"`
+	OwnerHandle   string `json:"owner_handle"`
+	AdminHandle   string `json:"admin_handle,omitempty"`
+	TechHandle    string `json:"tech_handle,omitempty"`
+	BillingHandle string `json:"billing_handle,omitempty"`
+	AuthCode      string `json:"auth_code,omitempty"`
+}
+
+// TradeDomainResponse represents a response for trading a domain.
+type TradeDomainResponse struct {
+	Code int    `json:"code"`
+	Data Domain `json:"data"`
+}
+
+// Trade changes the owner of a domain for registries that handle owner changes
+// as a paid trade operation. Trades are charged at the registry's trade fee and
+// usually complete only after the new registrant approves them.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/domains/trade
+func Trade(c *client.Client, req *TradeDomainRequest) (*Domain, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/v1beta/domains/trade"
+	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(httpReq)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result TradeDomainResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	if result.Code != 0 {
+		return nil, fmt.Errorf("trade failed with code %d", result.Code)
+	}
+
+	return &result.Data, nil
+}
@@ -0,0 +1,33 @@
+// Package domains_test contains tests for the domains package.
+package domains_test
+
+import (
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/charpand/terraform-provider-openprovider/internal/testutils"
+)
+
+func TestTradeDomain(t *testing.T) {
+	apiClient := testutils.SetupTestClient()
+
+	req := &domains.TradeDomainRequest{}
+	req.Domain.Name = "example"
+	req.Domain.Extension = "eu"
+	req.OwnerHandle = "newowner"
+
+	domain, err := domains.Trade(apiClient, req)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if domain == nil {
+		t.Log("Note: No domain returned by mock server (check your swagger examples)")
+		return
+	}
+
+	if domain.OwnerHandle == "" {
+		t.Log("Note: Owner handle not populated by mock server")
+	}
+}
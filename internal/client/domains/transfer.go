@@ -16,14 +16,20 @@ type TransferDomainRequest struct {
 		Name      string `json:"name"`
 		Extension string `json:"extension"`
 	} `json:"domain"`
-	AuthCode      string       `json:"auth_code"`
-	OwnerHandle   string       `json:"owner_handle"`
-	AdminHandle   string       `json:"admin_handle,omitempty"`
-	TechHandle    string       `json:"tech_handle,omitempty"`
-	BillingHandle string       `json:"billing_handle,omitempty"`
-	Autorenew     string       `json:"autorenew,omitempty"`
-	NSGroup       string       `json:"ns_group,omitempty"`
-	Nameservers   []Nameserver `json:"name_servers,omitempty"`
+	AuthCode                      string       `json:"auth_code"`
+	OwnerHandle                   string       `json:"owner_handle"`
+	AdminHandle                   string       `json:"admin_handle,omitempty"`
+	TechHandle                    string       `json:"tech_handle,omitempty"`
+	BillingHandle                 string       `json:"billing_handle,omitempty"`
+	Autorenew                     string       `json:"autorenew,omitempty"`
+	NSGroup                       string       `json:"ns_group,omitempty"`
+	Nameservers                   []Nameserver `json:"name_servers,omitempty"`
+	ImportNameserversFromRegistry bool         `json:"import_nameservers_from_registry,omitempty"`
+	ImportContactsFromRegistry    bool         `json:"import_contacts_from_registry,omitempty"`
+	ImportDNSZone                 bool         `json:"import_dns_zone,omitempty"`
+	IsPrivateWhoisEnabled         *bool        `json:"is_private_whois_enabled,omitempty"`
+
+	AdditionalData *AdditionalData `json:"additional_data,omitempty"`
 }
 
 // TransferDomainResponse represents a response for transferring a domain.
@@ -65,3 +71,43 @@ func Transfer(c *client.Client, req *TransferDomainRequest) (*Domain, error) {
 
 	return &result.Data, nil
 }
+
+// SendTransferApprovalEmailResponse represents a response for resending the transfer approval email.
+type SendTransferApprovalEmailResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Success bool `json:"success"`
+	} `json:"data"`
+}
+
+// SendTransferApprovalEmail resends the transfer approval (FOA) email for a pending transfer.
+//
+// Endpoint: POST https://api.openprovider.eu/v1beta/domains/{id}/transfer/send-foa1
+func SendTransferApprovalEmail(c *client.Client, id int) error {
+	path := fmt.Sprintf("/v1beta/domains/%d/transfer/send-foa1", id)
+	httpReq, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Do(httpReq)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return err
+	}
+
+	var result SendTransferApprovalEmailResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+
+	if result.Code != 0 {
+		return fmt.Errorf("resend transfer approval email failed with code %d", result.Code)
+	}
+
+	return nil
+}
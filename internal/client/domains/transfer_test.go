@@ -69,6 +69,9 @@ func TestTransferDomainWithImportOptions(t *testing.T) {
 	req.Domain.Extension = "com"
 	req.AuthCode = "12345678"
 	req.OwnerHandle = "testowner"
+	req.ImportContactsFromRegistry = true
+	req.ImportNameserversFromRegistry = true
+	req.ImportDNSZone = true
 
 	domain, err := domains.Transfer(apiClient, req)
 
@@ -110,3 +113,13 @@ func TestTransferDomainWithNameservers(t *testing.T) {
 		t.Log("Note: Nameservers not populated by mock server")
 	}
 }
+
+func TestSendTransferApprovalEmail(t *testing.T) {
+	apiClient := testutils.SetupTestClient()
+
+	err := domains.SendTransferApprovalEmail(apiClient, 123)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
@@ -12,15 +12,19 @@ import (
 
 // UpdateDomainRequest represents a request to update a domain.
 type UpdateDomainRequest struct {
-	AdminHandle     string       `json:"admin_handle,omitempty"`
-	TechHandle      string       `json:"tech_handle,omitempty"`
-	BillingHandle   string       `json:"billing_handle,omitempty"`
-	Autorenew       string       `json:"autorenew,omitempty"`
-	IsLocked        *bool        `json:"is_locked,omitempty"`
-	Nameservers     []Nameserver `json:"name_servers,omitempty"`
-	NSGroup         string       `json:"ns_group,omitempty"`
-	DnssecKeys      []DnssecKey  `json:"dnssec_keys,omitempty"`
-	IsDnssecEnabled *bool        `json:"is_dnssec_enabled,omitempty"`
+	OwnerHandle           string       `json:"owner_handle,omitempty"`
+	AdminHandle           string       `json:"admin_handle,omitempty"`
+	TechHandle            string       `json:"tech_handle,omitempty"`
+	BillingHandle         string       `json:"billing_handle,omitempty"`
+	Autorenew             string       `json:"autorenew,omitempty"`
+	IsLocked              *bool        `json:"is_locked,omitempty"`
+	Nameservers           []Nameserver `json:"name_servers,omitempty"`
+	NSGroup               string       `json:"ns_group,omitempty"`
+	DnssecKeys            []DnssecKey  `json:"dnssec_keys,omitempty"`
+	IsDnssecEnabled       *bool        `json:"is_dnssec_enabled,omitempty"`
+	IsPrivateWhoisEnabled *bool        `json:"is_private_whois_enabled,omitempty"`
+
+	AdditionalData *AdditionalData `json:"additional_data,omitempty"`
 }
 
 // UpdateDomainResponse represents a response for updating a domain.
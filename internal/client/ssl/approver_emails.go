@@ -0,0 +1,45 @@
+// Package ssl provides functionality for working with SSL/TLS certificates.
+package ssl
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// ListApproverEmails lists the addresses that can approve email validation of an
+// SSL order for the domain and product.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/ssl/approver-emails
+func ListApproverEmails(c *client.Client, domain string, productID int) ([]string, error) {
+	query := url.Values{}
+	query.Set("domain", domain)
+	query.Set("product_id", strconv.Itoa(productID))
+
+	path := "/v1beta/ssl/approver-emails?" + query.Encode()
+	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(httpReq)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result ListApproverEmailsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Data.Results, nil
+}
@@ -0,0 +1,38 @@
+// Package ssl provides functionality for working with SSL/TLS certificates.
+package ssl
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+func TestListApproverEmails(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1beta/ssl/approver-emails" {
+			http.NotFound(w, r)
+			return
+		}
+		query = r.URL.RawQuery
+		_, _ = fmt.Fprint(w, `{"code": 0, "data": {"results": ["admin@example.com", "webmaster@example.com"]}}`)
+	}))
+	defer server.Close()
+
+	c := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	emails, err := ListApproverEmails(c, "example.com", 12)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if query != "domain=example.com&product_id=12" {
+		t.Errorf("Expected domain and product_id in the query, got %q", query)
+	}
+	if len(emails) != 2 || emails[0] != "admin@example.com" {
+		t.Errorf("Expected 2 approver emails, got %v", emails)
+	}
+}
@@ -6,36 +6,75 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 )
 
-// ListOrders lists all SSL orders.
+// ordersPageSize is the number of orders requested per page when listing orders.
+const ordersPageSize = 100
+
+// ListOrdersOptions filters the orders returned by ListOrders. Empty fields are not
+// sent, so a nil or zero value lists all orders.
+type ListOrdersOptions struct {
+	// Status only returns orders with this status (e.g., StatusActive).
+	Status string
+	// CommonNamePattern only returns orders whose common name matches this
+	// pattern. The wildcard "*" is supported.
+	CommonNamePattern string
+}
+
+// ListOrders lists all SSL orders matching the options, requesting further pages
+// until every matching order has been returned.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/ssl/orders
-func ListOrders(c *client.Client) ([]SSLOrder, error) {
-	path := "/v1beta/ssl/orders"
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
-	if err != nil {
-		return nil, err
+func ListOrders(c *client.Client, opts *ListOrdersOptions) ([]SSLOrder, error) {
+	if opts == nil {
+		opts = &ListOrdersOptions{}
 	}
 
-	resp, err := c.Do(httpReq)
-	if resp != nil {
-		defer func() {
-			_ = resp.Body.Close()
-		}()
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(ordersPageSize))
+	if opts.Status != "" {
+		query.Set("status", opts.Status)
 	}
-	if err != nil {
-		return nil, err
+	if opts.CommonNamePattern != "" {
+		query.Set("common_name_pattern", opts.CommonNamePattern)
 	}
 
-	var result ListSSLOrdersResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
+	var all []SSLOrder
+	for offset := 0; ; offset += ordersPageSize {
+		query.Set("offset", strconv.Itoa(offset))
 
-	return result.Data.Results, nil
+		path := "/v1beta/ssl/orders?" + query.Encode()
+		httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.Do(httpReq)
+		if err != nil {
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			return nil, err
+		}
+
+		var result ListSSLOrdersResponse
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, result.Data.Results...)
+
+		// Stop on a short page, or once the reported total has been reached
+		if len(result.Data.Results) < ordersPageSize || (result.Data.Total > 0 && len(all) >= result.Data.Total) {
+			return all, nil
+		}
+	}
 }
 
 // GetOrder retrieves a specific SSL order by ID.
@@ -2,8 +2,12 @@
 package ssl
 
 import (
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
@@ -28,7 +32,7 @@ func TestListOrders(t *testing.T) {
 	}
 	c := client.NewClient(config)
 
-	orders, err := ListOrders(c)
+	orders, err := ListOrders(c, nil)
 	if err != nil {
 		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
 		return
@@ -42,6 +46,37 @@ func TestListOrders(t *testing.T) {
 	t.Logf("Retrieved %d SSL orders", len(orders))
 }
 
+func TestListOrdersFiltersAndPaginates(t *testing.T) {
+	const total = 120
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		results := make([]string, 0, limit)
+		for i := offset; i < offset+limit && i < total; i++ {
+			results = append(results, fmt.Sprintf(`{"id": %d, "common_name": "host%d.example.com", "status": "ACT"}`, i+1, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"results": [%s], "total": %d}}`, strings.Join(results, ","), total)
+	}))
+	defer server.Close()
+
+	c := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	orders, err := ListOrders(c, &ListOrdersOptions{Status: StatusActive, CommonNamePattern: "*.example.com"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(orders) != total {
+		t.Errorf("Expected %d orders across all pages, got %d", total, len(orders))
+	}
+	if len(queries) != 2 || !strings.Contains(queries[0], "status=ACT") || !strings.Contains(queries[0], "common_name_pattern=%2A.example.com") {
+		t.Errorf("Expected 2 filtered page requests, got %v", queries)
+	}
+}
+
 func TestGetOrder(t *testing.T) {
 	baseURL := os.Getenv("TEST_API_BASE_URL")
 	if baseURL == "" {
@@ -5,43 +5,63 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 )
 
-// ListProducts lists all available SSL products.
+// productsPageSize is the number of products requested per page when listing products.
+const productsPageSize = 100
+
+// ListProducts lists all available SSL products, requesting further pages until
+// every product has been returned.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/ssl/products
 func ListProducts(c *client.Client) ([]SSLProduct, error) {
-	path := "/v1beta/ssl/products"
-	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
-	if err != nil {
-		return nil, err
-	}
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(productsPageSize))
+	query.Set("with_price", "true")
 
-	resp, err := c.Do(httpReq)
-	if resp != nil {
-		defer func() {
-			_ = resp.Body.Close()
-		}()
-	}
-	if err != nil {
-		return nil, err
-	}
+	var all []SSLProduct
+	for offset := 0; ; offset += productsPageSize {
+		query.Set("offset", strconv.Itoa(offset))
 
-	var result ListSSLProductsResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, err
-	}
+		path := "/v1beta/ssl/products?" + query.Encode()
+		httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.Do(httpReq)
+		if err != nil {
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			return nil, err
+		}
+
+		var result ListSSLProductsResponse
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
 
-	return result.Data.Results, nil
+		all = append(all, result.Data.Results...)
+
+		// Stop on a short page, or once the reported total has been reached
+		if len(result.Data.Results) < productsPageSize || (result.Data.Total > 0 && len(all) >= result.Data.Total) {
+			return all, nil
+		}
+	}
 }
 
 // GetProduct retrieves a specific SSL product by ID.
 //
 // Endpoint: GET https://api.openprovider.eu/v1beta/ssl/products/{id}
 func GetProduct(c *client.Client, productID int) (*SSLProduct, error) {
-	path := fmt.Sprintf("/v1beta/ssl/products/%d", productID)
+	path := fmt.Sprintf("/v1beta/ssl/products/%d?with_price=true", productID)
 	httpReq, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
 	if err != nil {
 		return nil, err
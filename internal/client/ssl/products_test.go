@@ -2,8 +2,12 @@
 package ssl
 
 import (
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
@@ -42,6 +46,40 @@ func TestListProducts(t *testing.T) {
 	t.Logf("Retrieved %d SSL products", len(products))
 }
 
+func TestListProductsPaginates(t *testing.T) {
+	const total = 150
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		results := make([]string, 0, limit)
+		for i := offset; i < offset+limit && i < total; i++ {
+			results = append(results, fmt.Sprintf(`{"id": %d, "name": "Product %d", "prices": [{"period": 1, "create": {"reseller": {"currency": "EUR", "price": 9.5}}}]}`, i+1, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"results": [%s], "total": %d}}`, strings.Join(results, ","), total)
+	}))
+	defer server.Close()
+
+	c := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	products, err := ListProducts(c)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(products) != total {
+		t.Errorf("Expected %d products across all pages, got %d", total, len(products))
+	}
+	if len(queries) != 2 || !strings.Contains(queries[0], "with_price=true") {
+		t.Errorf("Expected 2 page requests with prices, got %v", queries)
+	}
+	if price := products[0].Prices[0].Create.Reseller; price.Price != 9.5 || price.Currency != "EUR" {
+		t.Errorf("Expected create price 9.5 EUR, got %+v", price)
+	}
+}
+
 func TestGetProduct(t *testing.T) {
 	baseURL := os.Getenv("TEST_API_BASE_URL")
 	if baseURL == "" {
@@ -1,28 +1,56 @@
 // Package ssl provides functionality for working with SSL/TLS certificates.
 package ssl
 
+// SSL order status codes returned by the Openprovider API.
+const (
+	// StatusActive indicates the certificate has been issued.
+	StatusActive = "ACT"
+	// StatusRequested indicates the order has been placed.
+	StatusRequested = "REQ"
+	// StatusPending indicates the order is waiting for domain control validation.
+	StatusPending = "PEN"
+	// StatusFailed indicates domain control validation failed.
+	StatusFailed = "FAI"
+	// StatusRejected indicates the certificate authority rejected the order.
+	StatusRejected = "REJ"
+	// StatusCancelled indicates the order has been cancelled.
+	StatusCancelled = "CAN"
+	// StatusExpired indicates the certificate has expired.
+	StatusExpired = "EXP"
+)
+
 // SSLOrder represents an SSL certificate order.
 // nolint:revive
 type SSLOrder struct {
-	ID                     int      `json:"id"`
-	ProductID              int      `json:"product_id"`
-	CommonName             string   `json:"common_name"`
-	BrandName              string   `json:"brand_name,omitempty"`
-	Status                 string   `json:"status"`
-	OrderDate              string   `json:"order_date"`
-	ActiveDate             string   `json:"active_date,omitempty"`
-	ExpirationDate         string   `json:"expiration_date,omitempty"`
-	Autorenew              string   `json:"autorenew,omitempty"`
-	OwnerHandle            string   `json:"owner_handle,omitempty"`
-	AdminHandle            string   `json:"admin_handle,omitempty"`
-	BillingHandle          string   `json:"billing_handle,omitempty"`
-	TechnicalHandle        string   `json:"technical_handle,omitempty"`
-	AdditionalDomains      []string `json:"additional_domains,omitempty"`
-	Certificate            string   `json:"certificate,omitempty"`
-	CertificateCA          string   `json:"certificate_ca,omitempty"`
-	DomainValidationMethod string   `json:"domain_validation_method,omitempty"`
-	ApprovedBy             string   `json:"approved_by,omitempty"`
-	ApprovedDate           string   `json:"approved_date,omitempty"`
+	ID                     int         `json:"id"`
+	ProductID              int         `json:"product_id"`
+	CommonName             string      `json:"common_name"`
+	BrandName              string      `json:"brand_name,omitempty"`
+	Status                 string      `json:"status"`
+	OrderDate              string      `json:"order_date"`
+	ActiveDate             string      `json:"active_date,omitempty"`
+	ExpirationDate         string      `json:"expiration_date,omitempty"`
+	Autorenew              string      `json:"autorenew,omitempty"`
+	OwnerHandle            string      `json:"owner_handle,omitempty"`
+	AdminHandle            string      `json:"admin_handle,omitempty"`
+	BillingHandle          string      `json:"billing_handle,omitempty"`
+	TechnicalHandle        string      `json:"technical_handle,omitempty"`
+	AdditionalDomains      []string    `json:"additional_domains,omitempty"`
+	Certificate            string      `json:"certificate,omitempty"`
+	CertificateCA          string      `json:"certificate_ca,omitempty"`
+	DomainValidationMethod string      `json:"domain_validation_method,omitempty"`
+	ApprovedBy             string      `json:"approved_by,omitempty"`
+	ApprovedDate           string      `json:"approved_date,omitempty"`
+	DCVRecords             []DCVRecord `json:"dcv_records,omitempty"`
+}
+
+// DCVRecord is a DNS record the certificate authority checks to validate control
+// of a domain in an order.
+type DCVRecord struct {
+	Domain string `json:"domain"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Value  string `json:"value"`
 }
 
 // SSLProduct represents an available SSL product.
@@ -37,6 +65,35 @@ type SSLProduct struct {
 	Encryption      string `json:"encryption,omitempty"`
 	FreeRefundDays  int    `json:"free_refund_period,omitempty"`
 	FreeReissueDays int    `json:"free_reissue_period,omitempty"`
+	// IsWildcardSupported reports whether the product can secure wildcard domains.
+	IsWildcardSupported bool `json:"is_wildcard_supported,omitempty"`
+	// MaxDomains is the number of domains, including the common name, a
+	// certificate can be issued for. Multi-domain products allow more than one.
+	MaxDomains int `json:"max_domains,omitempty"`
+	// MaxPeriod is the longest validity period in years.
+	MaxPeriod int            `json:"max_period,omitempty"`
+	Prices    []ProductPrice `json:"prices,omitempty"`
+}
+
+// Price is an amount in a currency.
+type Price struct {
+	Currency string  `json:"currency"`
+	Price    float64 `json:"price"`
+}
+
+// PriceAmounts holds the list price of the product and the price charged to the
+// reseller account.
+type PriceAmounts struct {
+	Product  Price `json:"product"`
+	Reseller Price `json:"reseller"`
+}
+
+// ProductPrice is the price of ordering and renewing an SSL product for a period
+// in years.
+type ProductPrice struct {
+	Period int          `json:"period"`
+	Create PriceAmounts `json:"create"`
+	Renew  PriceAmounts `json:"renew"`
 }
 
 // ListSSLOrdersResponse represents the API response for listing SSL orders.
@@ -69,6 +126,8 @@ type CreateSSLOrderRequest struct {
 	TechnicalHandle        string   `json:"technical_handle,omitempty"`
 	DomainValidationMethod string   `json:"domain_validation_method,omitempty"`
 	Autorenew              string   `json:"autorenew,omitempty"`
+	CSR                    string   `json:"csr,omitempty"`
+	ApproverEmail          string   `json:"approver_email,omitempty"`
 }
 
 // CreateSSLOrderResponse represents the API response for creating an SSL order.
@@ -104,6 +163,8 @@ type ReissueSSLOrderRequest struct {
 	CommonName             string   `json:"common_name,omitempty"`
 	AdditionalDomains      []string `json:"additional_domains,omitempty"`
 	DomainValidationMethod string   `json:"domain_validation_method,omitempty"`
+	CSR                    string   `json:"csr,omitempty"`
+	ApproverEmail          string   `json:"approver_email,omitempty"`
 }
 
 // ReissueSSLOrderResponse represents the API response for reissuing an SSL order.
@@ -136,3 +197,16 @@ type GetSSLProductResponse struct {
 	Code int        `json:"code"`
 	Data SSLProduct `json:"data"`
 }
+
+// ListApproverEmailsResponse represents the API response for listing the approver
+// emails of a domain.
+type ListApproverEmailsResponse struct {
+	Code int                            `json:"code"`
+	Data ListApproverEmailsResponseData `json:"data"`
+	Desc string                         `json:"desc"`
+}
+
+// ListApproverEmailsResponseData contains the approver emails list data.
+type ListApproverEmailsResponseData struct {
+	Results []string `json:"results"`
+}
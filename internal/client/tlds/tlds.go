@@ -0,0 +1,138 @@
+// Package tlds provides functionality for working with top-level domains.
+package tlds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// TLD represents a top-level domain and the capabilities of its registry.
+type TLD struct {
+	Name                       string   `json:"name"`
+	Description                string   `json:"description,omitempty"`
+	Status                     string   `json:"status"`
+	MinPeriod                  int      `json:"min_period"`
+	MaxPeriod                  int      `json:"max_period"`
+	RenewAvailable             bool     `json:"renew_available"`
+	MaxRenewPeriod             int      `json:"max_renew_period,omitempty"`
+	TransferAvailable          bool     `json:"transfer_available"`
+	IsTransferAuthCodeRequired bool     `json:"is_transfer_auth_code_required"`
+	DnssecAllowed              bool     `json:"dnssec_allowed"`
+	IsIDNAllowed               bool     `json:"is_idn_allowed"`
+	IsPrivateWhoisAllowed      bool     `json:"is_private_whois_allowed"`
+	IsLockable                 bool     `json:"is_lockable"`
+	IsTradeRequired            bool     `json:"is_trade_required"`
+	RequiredAdditionalData     []string `json:"required_additional_data,omitempty"`
+}
+
+// ListTLDsResponse represents a response from the TLD listing endpoint.
+type ListTLDsResponse struct {
+	Code int `json:"code"`
+	Data struct {
+		Results []TLD `json:"results"`
+		Total   int   `json:"total"`
+	} `json:"data"`
+}
+
+// GetTLDResponse represents a response for a single TLD.
+type GetTLDResponse struct {
+	Code int `json:"code"`
+	Data TLD `json:"data"`
+}
+
+// listPageSize is the number of TLDs requested per page when listing TLDs.
+const listPageSize = 100
+
+// ErrNotFound is returned by Get when Openprovider does not offer the TLD.
+var ErrNotFound = errors.New("tld not found")
+
+// List retrieves all TLDs offered by Openprovider, requesting further pages until
+// every TLD has been returned.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/tlds
+func List(c *client.Client) ([]TLD, error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(listPageSize))
+
+	var all []TLD
+	for offset := 0; ; offset += listPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+
+		path := "/v1beta/tlds?" + query.Encode()
+		req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.Do(req)
+		if err != nil {
+			if resp != nil {
+				_ = resp.Body.Close()
+			}
+			return nil, err
+		}
+
+		var result ListTLDsResponse
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if result.Code != 0 {
+			return nil, fmt.Errorf("TLD listing failed with code %d", result.Code)
+		}
+
+		all = append(all, result.Data.Results...)
+
+		// Stop on a short page, or once the reported total has been reached
+		if len(result.Data.Results) < listPageSize || (result.Data.Total > 0 && len(all) >= result.Data.Total) {
+			return all, nil
+		}
+	}
+}
+
+// Get retrieves a single TLD by name (e.g., "com" or ".com"). It returns an error
+// wrapping ErrNotFound when Openprovider does not offer the TLD.
+//
+// Endpoint: GET https://api.openprovider.eu/v1beta/tlds/{name}
+func Get(c *client.Client, name string) (*TLD, error) {
+	name = strings.ToLower(strings.TrimPrefix(name, "."))
+	path := fmt.Sprintf("/v1beta/tlds/%s", url.PathEscape(name))
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s%s", c.BaseURL, path), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(req)
+	if resp != nil {
+		defer func() {
+			_ = resp.Body.Close()
+		}()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+		}
+		return nil, err
+	}
+
+	var result GetTLDResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if result.Code != 0 {
+		return nil, fmt.Errorf("TLD retrieval failed with code %d", result.Code)
+	}
+	if result.Data.Name == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+
+	return &result.Data, nil
+}
@@ -0,0 +1,129 @@
+// Package tlds_test contains tests for the tlds package.
+package tlds_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+	"github.com/charpand/terraform-provider-openprovider/internal/testutils"
+)
+
+func TestListTLDs(t *testing.T) {
+	apiClient := testutils.SetupTestClient()
+
+	results, err := tlds.List(apiClient)
+	if err != nil {
+		t.Logf("Note: API returned error (expected if mock server not running): %v", err)
+		return
+	}
+
+	t.Logf("Retrieved %d TLDs", len(results))
+}
+
+func TestListTLDsPaginates(t *testing.T) {
+	var offsets []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		offsets = append(offsets, r.URL.Query().Get("offset"))
+
+		// Two full pages of 100 followed by a short page of 5
+		count := 100
+		if offset >= 200 {
+			count = 5
+		}
+		results := ""
+		for i := 0; i < count; i++ {
+			if i > 0 {
+				results += ","
+			}
+			results += fmt.Sprintf(`{"name": "tld%d"}`, offset+i)
+		}
+		_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"results": [%s], "total": 205}}`, results)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	results, err := tlds.List(apiClient)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(results) != 205 {
+		t.Errorf("Expected 205 TLDs, got %d", len(results))
+	}
+	if len(offsets) != 3 || offsets[0] != "0" || offsets[1] != "100" || offsets[2] != "200" {
+		t.Errorf("Expected offsets [0 100 200], got %v", offsets)
+	}
+}
+
+func TestGetTLD(t *testing.T) {
+	var requestedPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		_, _ = fmt.Fprint(w, `{"code": 0, "data": {
+			"name": "us",
+			"status": "ACT",
+			"min_period": 1,
+			"max_period": 10,
+			"transfer_available": true,
+			"is_transfer_auth_code_required": true,
+			"dnssec_allowed": true,
+			"is_private_whois_allowed": false,
+			"is_lockable": true,
+			"required_additional_data": ["nexus_category", "application_purpose"]
+		}}`)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	tld, err := tlds.Get(apiClient, ".US")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if requestedPath != "/v1beta/tlds/us" {
+		t.Errorf("Expected normalized path /v1beta/tlds/us, got %s", requestedPath)
+	}
+	if tld.MaxPeriod != 10 || !tld.DnssecAllowed || tld.IsPrivateWhoisAllowed || !tld.IsLockable {
+		t.Errorf("Unexpected TLD capabilities: %+v", tld)
+	}
+	if len(tld.RequiredAdditionalData) != 2 {
+		t.Errorf("Expected 2 required additional data fields, got %v", tld.RequiredAdditionalData)
+	}
+}
+
+func TestGetTLDNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = fmt.Fprint(w, `{"code": 404, "desc": "TLD not found"}`)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	_, err := tlds.Get(apiClient, "invalid")
+	if !errors.Is(err, tlds.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestGetTLDErrorCode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"code": 500, "data": {}}`)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+
+	if _, err := tlds.Get(apiClient, "com"); err == nil {
+		t.Error("Expected an error for a non-zero response code")
+	}
+}
@@ -0,0 +1,113 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &DomainAuthCodeResetAction{}
+	_ action.ActionWithConfigure = &DomainAuthCodeResetAction{}
+)
+
+// DomainAuthCodeResetAction is the action implementation.
+type DomainAuthCodeResetAction struct {
+	client *client.Client
+}
+
+// DomainAuthCodeResetModel describes the action data model.
+type DomainAuthCodeResetModel struct {
+	Domain types.String `tfsdk:"domain"`
+}
+
+// NewDomainAuthCodeResetAction returns a new instance of the domain auth code reset action.
+func NewDomainAuthCodeResetAction() action.Action {
+	return &DomainAuthCodeResetAction{}
+}
+
+// Metadata returns the action type name.
+func (a *DomainAuthCodeResetAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_auth_code_reset"
+}
+
+// Schema defines the schema for the action.
+func (a *DomainAuthCodeResetAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a new authorization (EPP) code for a domain, invalidating the previous one. Read the new code with the `openprovider_domain_auth_code` ephemeral resource.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to reset the auth code for (e.g., example.com).",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *DomainAuthCodeResetAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke resets the auth code of the domain. The new code is not returned, as
+// actions cannot produce values; it is only reported that the reset succeeded.
+func (a *DomainAuthCodeResetAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data DomainAuthCodeResetModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.Domain.ValueString()
+
+	domain, err := getDomainByName(a.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Finding Domain",
+			fmt.Sprintf("Could not find domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	if domain == nil {
+		resp.Diagnostics.AddError(
+			"Domain Not Found",
+			fmt.Sprintf("Domain %s not found", domainName),
+		)
+		return
+	}
+
+	if _, err := domains.ResetAuthCode(a.client, domain.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resetting Auth Code",
+			fmt.Sprintf("Could not reset the auth code of domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("The auth code of domain %s has been reset.", domainName),
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ action.Action              = &DomainOwnerVerificationResendAction{}
+	_ action.ActionWithConfigure = &DomainOwnerVerificationResendAction{}
+)
+
+// DomainOwnerVerificationResendAction is the action implementation.
+type DomainOwnerVerificationResendAction struct {
+	client *client.Client
+}
+
+// DomainOwnerVerificationResendModel describes the action data model.
+type DomainOwnerVerificationResendModel struct {
+	Domain types.String `tfsdk:"domain"`
+}
+
+// NewDomainOwnerVerificationResendAction returns a new instance of the owner verification resend action.
+func NewDomainOwnerVerificationResendAction() action.Action {
+	return &DomainOwnerVerificationResendAction{}
+}
+
+// Metadata returns the action type name.
+func (a *DomainOwnerVerificationResendAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_owner_verification_resend"
+}
+
+// Schema defines the schema for the action.
+func (a *DomainOwnerVerificationResendAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resends the ICANN verification email to the owner of a domain and restarts the verification period. Fails if no verification is pending for the domain.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name whose owner should receive the verification email (e.g., example.com).",
+				Required:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the action.
+func (a *DomainOwnerVerificationResendAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+// Invoke resends the verification email for the domain's owner.
+func (a *DomainOwnerVerificationResendAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data DomainOwnerVerificationResendModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.Domain.ValueString()
+
+	verification, err := customers.GetDomainEmailVerification(a.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Email Verification",
+			fmt.Sprintf("Could not read the owner email verification of domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	if verification == nil || verification.Status == customers.VerificationStatusVerified {
+		resp.Diagnostics.AddError(
+			"No Pending Owner Verification",
+			fmt.Sprintf("The owner of domain %s has no pending email verification.", domainName),
+		)
+		return
+	}
+
+	if err := customers.RestartEmailVerification(a.client, verification.Email); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resending Verification Email",
+			fmt.Sprintf("Could not resend the verification email to %s for domain %s: %s", verification.Email, domainName, err.Error()),
+		)
+		return
+	}
+
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Verification email for domain %s resent to %s.", domainName, verification.Email),
+		})
+	}
+}
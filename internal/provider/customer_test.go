@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCustomerResourceUpdateClearsAdditionalData(t *testing.T) {
+	ctx := context.Background()
+
+	var updateReq customers.UpdateCustomerRequest
+	r := &CustomerResource{client: newFakeAPIClient(t, fakeAPI{
+		"PUT /v1beta/customers/{handle}": func(w http.ResponseWriter, req *http.Request) {
+			_ = json.NewDecoder(req.Body).Decode(&updateReq)
+			writeJSON(w, map[string]any{"code": 0, "data": map[string]any{"handle": "XX123456-XX"}})
+		},
+		"GET /v1beta/customers/{handle}": respondWith(`{"code": 0, "data": {"handle": "XX123456-XX", "email": "test@example.com"}}`),
+	})}
+
+	config := resourceConfig(t, r, map[string]tftypes.Value{
+		"id":     tftypes.NewValue(tftypes.String, "XX123456-XX"),
+		"handle": tftypes.NewValue(tftypes.String, "XX123456-XX"),
+		"email":  tftypes.NewValue(tftypes.String, "test@example.com"),
+	})
+	plan := tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}
+	state := tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}
+	diags := state.SetAttribute(ctx, path.Root("additional_data"), &CustomerAdditionalDataModel{
+		BirthDate:                 types.StringNull(),
+		CompanyRegistrationNumber: types.StringNull(),
+		PassportNumber:            types.StringNull(),
+		SocialSecurityNumber:      types.StringValue("12345678Z"),
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if updateReq.AdditionalData == nil || updateReq.AdditionalData.SocialSecurityNumber != "" {
+		t.Errorf("Expected an empty additional_data to be sent, got %+v", updateReq.AdditionalData)
+	}
+
+	var model CustomerModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+	if model.AdditionalData != nil {
+		t.Errorf("Expected additional_data to be removed from state, got %+v", model.AdditionalData)
+	}
+}
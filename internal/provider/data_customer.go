@@ -113,6 +113,29 @@ func (d *CustomerDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 					},
 				},
 			},
+			"additional_data": schema.SingleNestedBlock{
+				MarkdownDescription: "Registry-specific customer data.",
+				Attributes: map[string]schema.Attribute{
+					"birth_date": schema.StringAttribute{
+						MarkdownDescription: "Date of birth.",
+						Computed:            true,
+					},
+					"company_registration_number": schema.StringAttribute{
+						MarkdownDescription: "Company registration number.",
+						Computed:            true,
+					},
+					"passport_number": schema.StringAttribute{
+						MarkdownDescription: "Passport or identity card number.",
+						Computed:            true,
+						Sensitive:           true,
+					},
+					"social_security_number": schema.StringAttribute{
+						MarkdownDescription: "Social security or tax identification number.",
+						Computed:            true,
+						Sensitive:           true,
+					},
+				},
+			},
 			"name": schema.SingleNestedBlock{
 				MarkdownDescription: "The customer's name.",
 				Attributes: map[string]schema.Attribute{
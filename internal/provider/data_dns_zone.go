@@ -32,6 +32,9 @@ type DNSZoneDataSourceModel struct {
 	CreationDate     types.String `tfsdk:"creation_date"`
 	ModificationDate types.String `tfsdk:"modification_date"`
 	ID               types.String `tfsdk:"id"`
+	IsDnssecEnabled  types.Bool   `tfsdk:"is_dnssec_enabled"`
+	DnskeyRecords    types.List   `tfsdk:"dnskey_records"`
+	DSRecords        types.List   `tfsdk:"ds_records"`
 }
 
 // NewDNSZoneDataSource returns a new instance of the DNS zone data source.
@@ -77,6 +80,58 @@ func (d *DNSZoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				MarkdownDescription: "The zone identifier.",
 				Computed:            true,
 			},
+			"is_dnssec_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is signed with DNSSEC by OpenProvider DNS.",
+				Computed:            true,
+			},
+			"dnskey_records": schema.ListNestedAttribute{
+				MarkdownDescription: "The key signing keys of the zone, when it is signed.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"algorithm": schema.Int64Attribute{
+							MarkdownDescription: "The algorithm number.",
+							Computed:            true,
+						},
+						"flags": schema.Int64Attribute{
+							MarkdownDescription: "The flags field.",
+							Computed:            true,
+						},
+						"protocol": schema.Int64Attribute{
+							MarkdownDescription: "The protocol field.",
+							Computed:            true,
+						},
+						"public_key": schema.StringAttribute{
+							MarkdownDescription: "The public key.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ds_records": schema.ListNestedAttribute{
+				MarkdownDescription: "The SHA-256 DS records of `dnskey_records`, to be published at the registry.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_tag": schema.Int64Attribute{
+							MarkdownDescription: "The key tag of the DNSKEY.",
+							Computed:            true,
+						},
+						"algorithm": schema.Int64Attribute{
+							MarkdownDescription: "The algorithm number of the DNSKEY.",
+							Computed:            true,
+						},
+						"digest_type": schema.Int64Attribute{
+							MarkdownDescription: "The digest type (2 for SHA-256).",
+							Computed:            true,
+						},
+						"digest": schema.StringAttribute{
+							MarkdownDescription: "The hexadecimal digest.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -126,6 +181,11 @@ func (d *DNSZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	config.CreationDate = types.StringValue(zone.CreationDate)
 	config.ModificationDate = types.StringValue(zone.ModificationDate)
 	config.ID = types.StringValue(fmt.Sprintf("%s.%s", zone.Name, zone.Extension))
+	config.IsDnssecEnabled = types.BoolValue(zone.IsDnssecEnabled)
+	config.DnskeyRecords, config.DSRecords = mapZoneKeysToState(ctx, zoneName, zoneSigningKeys(zone), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, &config)
 	resp.Diagnostics.Append(diags...)
@@ -4,17 +4,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &DomainDataSource{}
-	_ datasource.DataSourceWithConfigure = &DomainDataSource{}
+	_ datasource.DataSource                   = &DomainDataSource{}
+	_ datasource.DataSourceWithConfigure      = &DomainDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &DomainDataSource{}
 )
 
 // DomainDataSource is the data source implementation.
@@ -34,46 +38,152 @@ func (d *DomainDataSource) Metadata(_ context.Context, req datasource.MetadataRe
 
 // Schema defines the schema for the data source.
 func (d *DomainDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := domainAttributes()
+	attributes["domain_id"] = schema.Int64Attribute{
+		MarkdownDescription: "The numeric OpenProvider domain ID to look up. Set either `domain_id` or `domain` to look up the domain. `id` is the domain name and cannot be used for the lookup.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["domain"] = schema.StringAttribute{
+		MarkdownDescription: "The domain name to look up (e.g., example.com). Set either `domain_id` or `domain` to look up the domain.",
+		Optional:            true,
+		Computed:            true,
+	}
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Retrieves information about an OpenProvider domain.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The domain identifier (domain name).",
-				Computed:            true,
-			},
-			"domain": schema.StringAttribute{
-				MarkdownDescription: "The domain name to look up (e.g., example.com).",
-				Required:            true,
-			},
-			"status": schema.StringAttribute{
-				MarkdownDescription: "The current status of the domain.",
-				Computed:            true,
-			},
-			"autorenew": schema.BoolAttribute{
-				MarkdownDescription: "Whether the domain is set to auto-renew.",
-				Computed:            true,
-			},
-			"owner_handle": schema.StringAttribute{
-				MarkdownDescription: "The owner contact handle for the domain.",
-				Computed:            true,
-			},
-			"admin_handle": schema.StringAttribute{
-				MarkdownDescription: "The admin contact handle for the domain.",
-				Computed:            true,
-			},
-			"tech_handle": schema.StringAttribute{
-				MarkdownDescription: "The tech contact handle for the domain.",
-				Computed:            true,
-			},
-			"billing_handle": schema.StringAttribute{
-				MarkdownDescription: "The billing contact handle for the domain.",
-				Computed:            true,
+		Attributes:          attributes,
+	}
+}
+
+// domainAttributes returns the computed attributes describing a domain, shared by the
+// openprovider_domain and openprovider_domains data sources.
+func domainAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The domain identifier (domain name).",
+			Computed:            true,
+		},
+		"domain_id": schema.Int64Attribute{
+			MarkdownDescription: "The numeric OpenProvider domain ID.",
+			Computed:            true,
+		},
+		"domain": schema.StringAttribute{
+			MarkdownDescription: "The domain name (e.g., example.com).",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The current status of the domain.",
+			Computed:            true,
+		},
+		"autorenew": schema.BoolAttribute{
+			MarkdownDescription: "Whether the domain is set to auto-renew.",
+			Computed:            true,
+		},
+		"owner_handle": schema.StringAttribute{
+			MarkdownDescription: "The owner contact handle for the domain.",
+			Computed:            true,
+		},
+		"admin_handle": schema.StringAttribute{
+			MarkdownDescription: "The admin contact handle for the domain.",
+			Computed:            true,
+		},
+		"tech_handle": schema.StringAttribute{
+			MarkdownDescription: "The tech contact handle for the domain.",
+			Computed:            true,
+		},
+		"billing_handle": schema.StringAttribute{
+			MarkdownDescription: "The billing contact handle for the domain.",
+			Computed:            true,
+		},
+		"period": schema.Int64Attribute{
+			MarkdownDescription: "Registration period in years.",
+			Computed:            true,
+		},
+		"is_locked": schema.BoolAttribute{
+			MarkdownDescription: "Whether the domain is locked against transfers at the registry.",
+			Computed:            true,
+		},
+		"whois_privacy": schema.BoolAttribute{
+			MarkdownDescription: "Whether WHOIS privacy protection is enabled for the domain.",
+			Computed:            true,
+		},
+		"whois_privacy_status": schema.StringAttribute{
+			MarkdownDescription: "The WHOIS privacy state of the domain: `enabled`, `disabled` or `not_allowed`.",
+			Computed:            true,
+		},
+		"creation_date": schema.StringAttribute{
+			MarkdownDescription: "The date the domain was registered or transferred to OpenProvider.",
+			Computed:            true,
+		},
+		"active_date": schema.StringAttribute{
+			MarkdownDescription: "The date the domain became active.",
+			Computed:            true,
+		},
+		"expiration_date": schema.StringAttribute{
+			MarkdownDescription: "The domain expiration date.",
+			Computed:            true,
+		},
+		"is_abusive": schema.BoolAttribute{
+			MarkdownDescription: "Whether the domain has been flagged for abuse.",
+			Computed:            true,
+		},
+		"can_renew": schema.BoolAttribute{
+			MarkdownDescription: "Whether the domain can currently be renewed.",
+			Computed:            true,
+		},
+		"name_servers": schema.ListNestedAttribute{
+			MarkdownDescription: "The nameservers the domain is delegated to.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The hostname of the nameserver (e.g., ns1.example.com).",
+						Computed:            true,
+					},
+					"ip": schema.StringAttribute{
+						MarkdownDescription: "The IPv4 glue address, if any.",
+						Computed:            true,
+					},
+					"ip6": schema.StringAttribute{
+						MarkdownDescription: "The IPv6 glue address, if any.",
+						Computed:            true,
+					},
+				},
 			},
-			"period": schema.Int64Attribute{
-				MarkdownDescription: "Registration period in years.",
-				Computed:            true,
+		},
+		"ns_group": schema.StringAttribute{
+			MarkdownDescription: "The nameserver group the domain uses, if any.",
+			Computed:            true,
+		},
+		"dnssec_keys": schema.ListNestedAttribute{
+			MarkdownDescription: "The DNSSEC keys published for the domain.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"algorithm": schema.Int64Attribute{
+						MarkdownDescription: "The algorithm number.",
+						Computed:            true,
+					},
+					"flags": schema.Int64Attribute{
+						MarkdownDescription: "The flags field (257 for KSK or 256 for ZSK).",
+						Computed:            true,
+					},
+					"protocol": schema.Int64Attribute{
+						MarkdownDescription: "The protocol field (3 for DNSSEC).",
+						Computed:            true,
+					},
+					"public_key": schema.StringAttribute{
+						MarkdownDescription: "The public key.",
+						Computed:            true,
+					},
+				},
 			},
 		},
+		"is_dnssec_enabled": schema.BoolAttribute{
+			MarkdownDescription: "Whether DNSSEC is enabled for the domain.",
+			Computed:            true,
+		},
 	}
 }
 
@@ -95,23 +205,49 @@ func (d *DomainDataSource) Configure(_ context.Context, req datasource.Configure
 	d.client = client
 }
 
-// Read retrieves the domain information.
+// ValidateConfig requires exactly one of domain_id or domain.
+func (d *DomainDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config DomainDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.DomainID.IsUnknown() || config.Domain.IsUnknown() {
+		return
+	}
+
+	if config.DomainID.IsNull() == config.Domain.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Domain Lookup",
+			"Exactly one of domain_id or domain must be set to look up a domain.",
+		)
+	}
+}
+
+// Read retrieves the domain information, by ID or by name.
 func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var config DomainModel
+	var config DomainDataSourceModel
 	diags := req.Config.Get(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	domainName := config.Domain.ValueString()
+	var domain *domains.Domain
+	var err error
+	lookup := config.Domain.ValueString()
+
+	if !config.DomainID.IsNull() {
+		lookup = strconv.FormatInt(config.DomainID.ValueInt64(), 10)
+		domain, err = domains.Get(d.client, int(config.DomainID.ValueInt64()))
+		if err == nil && domain.ID == 0 {
+			domain = nil
+		}
+	} else {
+		domain, err = getDomainByName(d.client, lookup)
+	}
 
-	// Get domain by name
-	domain, err := getDomainByName(d.client, domainName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Domain",
-			fmt.Sprintf("Could not read domain %s: %s", domainName, err.Error()),
+			fmt.Sprintf("Could not read domain %s: %s", lookup, err.Error()),
 		)
 		return
 	}
@@ -119,14 +255,26 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if domain == nil {
 		resp.Diagnostics.AddError(
 			"Domain Not Found",
-			fmt.Sprintf("Domain %s not found", domainName),
+			fmt.Sprintf("Domain %s not found", lookup),
 		)
 		return
 	}
 
-	// Map to state
-	var state DomainModel
+	state := mapDomainToDataSourceModel(ctx, domain, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// mapDomainToDataSourceModel converts a domain API response to the data source model.
+func mapDomainToDataSourceModel(ctx context.Context, domain *domains.Domain, diags *diag.Diagnostics) DomainDataSourceModel {
+	var state DomainDataSourceModel
+	domainName := domain.Domain.Name + "." + domain.Domain.Extension
 	state.ID = types.StringValue(domainName)
+	state.DomainID = types.Int64Value(int64(domain.ID))
 	state.Domain = types.StringValue(domainName)
 	state.Status = types.StringValue(domain.Status)
 
@@ -143,6 +291,32 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		state.Autorenew = types.BoolValue(false)
 	}
 
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	state.IsLocked = types.BoolValue(domain.IsLocked)
+	state.WhoisPrivacy = types.BoolValue(domain.IsPrivateWhoisEnabled)
+	state.PrivacyStatus = types.StringValue(whoisPrivacyStatus(domain))
+	state.Period = types.Int64Null()
+
+	// Map lifecycle dates and flags
+	state.CreationDate = stringValueOrNull(domain.CreationDate)
+	state.ActiveDate = stringValueOrNull(domain.ActiveDate)
+	state.ExpirationDate = stringValueOrNull(domain.ExpirationDate)
+	state.IsAbusive = types.BoolValue(domain.IsAbusive)
+	state.CanRenew = types.BoolValue(domain.CanRenew)
+
+	// Map delegation
+	state.Nameservers = make([]DomainNameserverModel, 0, len(domain.Nameservers))
+	for _, ns := range domain.Nameservers {
+		state.Nameservers = append(state.Nameservers, DomainNameserverModel{
+			Name: types.StringValue(ns.Name),
+			IP:   stringValueOrNull(ns.IP),
+			IP6:  stringValueOrNull(ns.IP6),
+		})
+	}
+	state.NSGroup = stringValueOrNull(domain.NSGroup)
+
+	// Map DNSSEC
+	state.DnssecKeys = mapDnssecKeysToState(ctx, domain.DnssecKeys, diags)
+	state.IsDnssecEnabled = types.BoolValue(domain.IsDnssecEnabled)
+
+	return state
 }
@@ -0,0 +1,195 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &DomainsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &DomainsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &DomainsDataSource{}
+)
+
+// DomainsDataSource is the data source implementation.
+type DomainsDataSource struct {
+	client *client.Client
+}
+
+// DomainsDataSourceModel describes the data source data model.
+type DomainsDataSourceModel struct {
+	ID                types.String            `tfsdk:"id"`
+	Extension         types.String            `tfsdk:"extension"`
+	Status            types.String            `tfsdk:"status"`
+	NamePattern       types.String            `tfsdk:"name_pattern"`
+	OwnerHandle       types.String            `tfsdk:"owner_handle"`
+	NSGroup           types.String            `tfsdk:"ns_group"`
+	ExpiresWithinDays types.Int64             `tfsdk:"expires_within_days"`
+	Domains           []DomainDataSourceModel `tfsdk:"domains"`
+}
+
+// NewDomainsDataSource returns a new instance of the domains data source.
+func NewDomainsDataSource() datasource.DataSource {
+	return &DomainsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *DomainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+// Schema defines the schema for the data source.
+func (d *DomainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the domains in the OpenProvider account, optionally filtered by extension, status, name, owner, nameserver group or upcoming expiry.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier.",
+				Computed:            true,
+			},
+			"extension": schema.StringAttribute{
+				MarkdownDescription: "Only return domains under this extension, with or without the leading dot (e.g., `nl`).",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return domains with this status (e.g., `ACT`).",
+				Optional:            true,
+			},
+			"name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Only return domains whose name, without extension, matches this pattern. `*` matches any characters (e.g., `shop-*`).",
+				Optional:            true,
+			},
+			"owner_handle": schema.StringAttribute{
+				MarkdownDescription: "Only return domains owned by this contact handle. Domains that only use it as admin, tech or billing contact are not returned.",
+				Optional:            true,
+			},
+			"ns_group": schema.StringAttribute{
+				MarkdownDescription: "Only return domains that use this nameserver group.",
+				Optional:            true,
+			},
+			"expires_within_days": schema.Int64Attribute{
+				MarkdownDescription: "Only return domains that expire within this number of days, including domains that have already expired. Applied after the other filters.",
+				Optional:            true,
+			},
+			"domains": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching domains.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: domainAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *DomainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig rejects a negative expiry window.
+func (d *DomainsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var expiresWithinDays types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_within_days"), &expiresWithinDays)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !expiresWithinDays.IsNull() && !expiresWithinDays.IsUnknown() && expiresWithinDays.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_within_days"),
+			"Invalid Expiry Window",
+			fmt.Sprintf("expires_within_days must not be negative, got: %d", expiresWithinDays.ValueInt64()),
+		)
+	}
+}
+
+// Read retrieves the matching domains.
+func (d *DomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DomainsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := domains.List(d.client, &domains.ListDomainsOptions{
+		Extension:   strings.ToLower(config.Extension.ValueString()),
+		Status:      strings.ToUpper(config.Status.ValueString()),
+		NamePattern: config.NamePattern.ValueString(),
+		OwnerHandle: config.OwnerHandle.ValueString(),
+		NSGroup:     config.NSGroup.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Domains",
+			fmt.Sprintf("Could not list domains: %s", err.Error()),
+		)
+		return
+	}
+
+	// The API matches the handle in any contact role, so the owner is checked here
+	ownerHandle := config.OwnerHandle.ValueString()
+
+	// The expiry window is not supported by the API and is applied here
+	var expiresBefore time.Time
+	if !config.ExpiresWithinDays.IsNull() {
+		expiresBefore = time.Now().AddDate(0, 0, int(config.ExpiresWithinDays.ValueInt64()))
+	}
+
+	config.Domains = make([]DomainDataSourceModel, 0, len(results))
+	for _, domain := range results {
+		if ownerHandle != "" && !strings.EqualFold(domain.OwnerHandle, ownerHandle) {
+			continue
+		}
+		if !expiresBefore.IsZero() && !expiresBeforeDate(domain.ExpirationDate, expiresBefore) {
+			continue
+		}
+		config.Domains = append(config.Domains, mapDomainToDataSourceModel(ctx, &domain, &resp.Diagnostics))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue("domains")
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// expiresBeforeDate reports whether a domain expiration date is before the given
+// time. Domains without a recognizable expiration date are not considered expiring.
+func expiresBeforeDate(expirationDate string, before time.Time) bool {
+	if expirationDate == "" {
+		return false
+	}
+	expires, err := parseDomainDate(expirationDate)
+	if err != nil {
+		return false
+	}
+	return expires.Before(before)
+}
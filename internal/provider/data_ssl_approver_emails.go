@@ -0,0 +1,116 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &SSLApproverEmailsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SSLApproverEmailsDataSource{}
+)
+
+// SSLApproverEmailsDataSource is the data source implementation.
+type SSLApproverEmailsDataSource struct {
+	client *client.Client
+}
+
+// SSLApproverEmailsDataSourceModel describes the data source data model.
+type SSLApproverEmailsDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Domain    types.String `tfsdk:"domain"`
+	ProductID types.Int64  `tfsdk:"product_id"`
+	Emails    types.List   `tfsdk:"emails"`
+}
+
+// NewSSLApproverEmailsDataSource returns a new instance of the SSL approver emails data source.
+func NewSSLApproverEmailsDataSource() datasource.DataSource {
+	return &SSLApproverEmailsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *SSLApproverEmailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_approver_emails"
+}
+
+// Schema defines the schema for the data source.
+func (d *SSLApproverEmailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the email addresses that can approve an SSL order with `domain_validation_method = \"email\"`, for use as `approver_email` on `openprovider_ssl_order`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier, `<product_id>/<domain>`.",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain the certificate is ordered for (e.g., example.com).",
+				Required:            true,
+			},
+			"product_id": schema.Int64Attribute{
+				MarkdownDescription: "The SSL product ID of the order.",
+				Required:            true,
+			},
+			"emails": schema.ListAttribute{
+				MarkdownDescription: "The allowed approver email addresses.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SSLApproverEmailsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the approver emails of the domain.
+func (d *SSLApproverEmailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SSLApproverEmailsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	emails, err := ssl.ListApproverEmails(d.client, config.Domain.ValueString(), int(config.ProductID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SSL Approver Emails",
+			fmt.Sprintf("Could not list approver emails for %s: %s", config.Domain.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	if emails == nil {
+		emails = []string{}
+	}
+	emailsValue, listDiags := types.ListValueFrom(ctx, types.StringType, emails)
+	resp.Diagnostics.Append(listDiags...)
+	config.Emails = emailsValue
+	config.ID = types.StringValue(fmt.Sprintf("%d/%s", config.ProductID.ValueInt64(), config.Domain.ValueString()))
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// sslApproverEmailsAPI returns the routes of a fake API listing the approver emails
+// of example.com.
+func sslApproverEmailsAPI() fakeAPI {
+	return fakeAPI{
+		"GET /v1beta/ssl/approver-emails": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("domain") != "example.com" {
+				http.NotFound(w, r)
+				return
+			}
+			writeJSON(w, ssl.ListApproverEmailsResponse{
+				Data: ssl.ListApproverEmailsResponseData{Results: []string{"admin@example.com", "webmaster@example.com"}},
+			})
+		},
+	}
+}
+
+func TestSSLApproverEmailsDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	d := &SSLApproverEmailsDataSource{client: newFakeAPIClient(t, sslApproverEmailsAPI())}
+
+	config := dataSourceConfig(t, d, map[string]tftypes.Value{
+		"domain":     tftypes.NewValue(tftypes.String, "example.com"),
+		"product_id": tftypes.NewValue(tftypes.Number, 1),
+	})
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var state SSLApproverEmailsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var emails []string
+	resp.Diagnostics.Append(state.Emails.ElementsAs(ctx, &emails, false)...)
+	if len(emails) != 2 || emails[0] != "admin@example.com" || state.ID.ValueString() != "1/example.com" {
+		t.Errorf("Expected 2 approver emails for 1/example.com, got %v for %s", emails, state.ID)
+	}
+}
@@ -0,0 +1,374 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &SSLOrderDataSource{}
+	_ datasource.DataSourceWithConfigure      = &SSLOrderDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &SSLOrderDataSource{}
+)
+
+// SSLOrderDataSource is the data source implementation.
+type SSLOrderDataSource struct {
+	client *client.Client
+}
+
+// SSLOrderDataSourceModel describes the data source data model.
+type SSLOrderDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	CommonName             types.String `tfsdk:"common_name"`
+	ProductID              types.Int64  `tfsdk:"product_id"`
+	BrandName              types.String `tfsdk:"brand_name"`
+	Status                 types.String `tfsdk:"status"`
+	OrderDate              types.String `tfsdk:"order_date"`
+	ActiveDate             types.String `tfsdk:"active_date"`
+	ExpirationDate         types.String `tfsdk:"expiration_date"`
+	Autorenew              types.Bool   `tfsdk:"autorenew"`
+	OwnerHandle            types.String `tfsdk:"owner_handle"`
+	AdminHandle            types.String `tfsdk:"admin_handle"`
+	BillingHandle          types.String `tfsdk:"billing_handle"`
+	TechnicalHandle        types.String `tfsdk:"technical_handle"`
+	AdditionalDomains      types.List   `tfsdk:"additional_domains"`
+	DomainValidationMethod types.String `tfsdk:"domain_validation_method"`
+	CertificatePEM         types.String `tfsdk:"certificate_pem"`
+	CABundlePEM            types.String `tfsdk:"ca_bundle_pem"`
+	FullChainPEM           types.String `tfsdk:"full_chain_pem"`
+	SerialNumber           types.String `tfsdk:"serial_number"`
+	NotBefore              types.String `tfsdk:"not_before"`
+	NotAfter               types.String `tfsdk:"not_after"`
+	FingerprintSHA256      types.String `tfsdk:"fingerprint_sha256"`
+	Issuer                 types.String `tfsdk:"issuer"`
+	SubjectAltNames        types.List   `tfsdk:"subject_alternative_names"`
+}
+
+// NewSSLOrderDataSource returns a new instance of the SSL order data source.
+func NewSSLOrderDataSource() datasource.DataSource {
+	return &SSLOrderDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *SSLOrderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_order"
+}
+
+// Schema defines the schema for the data source.
+func (d *SSLOrderDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := sslOrderAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The numeric OpenProvider SSL order ID. Set either `id` or `common_name` to look up the order.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["common_name"] = schema.StringAttribute{
+		MarkdownDescription: "The common name of the certificate to look up (e.g., example.com). When several orders share the common name, " +
+			"the active order that expires last is used, or the most recent order if none is active. Set either `id` or `common_name` to look up the order.",
+		Optional: true,
+		Computed: true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves an SSL certificate order, including orders that are not managed by Terraform.",
+		Attributes:          attributes,
+	}
+}
+
+// sslOrderAttributes returns the computed attributes describing an SSL order, shared
+// by the openprovider_ssl_order and openprovider_ssl_orders data sources.
+func sslOrderAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The numeric OpenProvider SSL order ID.",
+			Computed:            true,
+		},
+		"common_name": schema.StringAttribute{
+			MarkdownDescription: "The common name of the certificate.",
+			Computed:            true,
+		},
+		"product_id": schema.Int64Attribute{
+			MarkdownDescription: "The SSL product ID of the order.",
+			Computed:            true,
+		},
+		"brand_name": schema.StringAttribute{
+			MarkdownDescription: "The brand name of the SSL certificate.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the order (e.g., `ACT`, `PEN`, `EXP`).",
+			Computed:            true,
+		},
+		"order_date": schema.StringAttribute{
+			MarkdownDescription: "The date the order was placed.",
+			Computed:            true,
+		},
+		"active_date": schema.StringAttribute{
+			MarkdownDescription: "The date the certificate was issued.",
+			Computed:            true,
+		},
+		"expiration_date": schema.StringAttribute{
+			MarkdownDescription: "The date the certificate expires.",
+			Computed:            true,
+		},
+		"autorenew": schema.BoolAttribute{
+			MarkdownDescription: "Whether the certificate is renewed automatically.",
+			Computed:            true,
+		},
+		"owner_handle": schema.StringAttribute{
+			MarkdownDescription: "The owner contact handle.",
+			Computed:            true,
+		},
+		"admin_handle": schema.StringAttribute{
+			MarkdownDescription: "The admin contact handle.",
+			Computed:            true,
+		},
+		"billing_handle": schema.StringAttribute{
+			MarkdownDescription: "The billing contact handle.",
+			Computed:            true,
+		},
+		"technical_handle": schema.StringAttribute{
+			MarkdownDescription: "The technical contact handle.",
+			Computed:            true,
+		},
+		"additional_domains": schema.ListAttribute{
+			MarkdownDescription: "The additional domains (SANs) of the order.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"domain_validation_method": schema.StringAttribute{
+			MarkdownDescription: "The domain control validation method of the order.",
+			Computed:            true,
+		},
+		"certificate_pem": schema.StringAttribute{
+			MarkdownDescription: "The issued certificate in PEM format. Empty until the certificate has been issued.",
+			Computed:            true,
+		},
+		"ca_bundle_pem": schema.StringAttribute{
+			MarkdownDescription: "The intermediate CA certificates in PEM format.",
+			Computed:            true,
+		},
+		"full_chain_pem": schema.StringAttribute{
+			MarkdownDescription: "The issued certificate followed by the intermediate CA certificates, in PEM format.",
+			Computed:            true,
+		},
+		"serial_number": schema.StringAttribute{
+			MarkdownDescription: "The serial number of the issued certificate, in hexadecimal.",
+			Computed:            true,
+		},
+		"not_before": schema.StringAttribute{
+			MarkdownDescription: "The start of the validity period of the issued certificate, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"not_after": schema.StringAttribute{
+			MarkdownDescription: "The end of the validity period of the issued certificate, in RFC 3339 format.",
+			Computed:            true,
+		},
+		"fingerprint_sha256": schema.StringAttribute{
+			MarkdownDescription: "The SHA-256 fingerprint of the issued certificate, in hexadecimal.",
+			Computed:            true,
+		},
+		"issuer": schema.StringAttribute{
+			MarkdownDescription: "The distinguished name of the issuer of the certificate.",
+			Computed:            true,
+		},
+		"subject_alternative_names": schema.ListAttribute{
+			MarkdownDescription: "The DNS names and IP addresses the issued certificate is valid for.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SSLOrderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig requires exactly one of id or common_name.
+func (d *SSLOrderDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config SSLOrderDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ID.IsUnknown() || config.CommonName.IsUnknown() {
+		return
+	}
+
+	if config.ID.IsNull() == config.CommonName.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid SSL Order Lookup",
+			"Exactly one of id or common_name must be set to look up an SSL order.",
+		)
+		return
+	}
+
+	if !config.ID.IsNull() {
+		if _, err := strconv.Atoi(config.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Invalid SSL Order ID",
+				fmt.Sprintf("id must be the numeric OpenProvider SSL order ID, got: %s", config.ID.ValueString()),
+			)
+		}
+	}
+}
+
+// Read retrieves the SSL order, by ID or by common name.
+func (d *SSLOrderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SSLOrderDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookup := config.CommonName.ValueString()
+	orderID := 0
+	if !config.ID.IsNull() {
+		lookup = config.ID.ValueString()
+		id, err := strconv.Atoi(lookup)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid SSL Order ID",
+				fmt.Sprintf("id must be the numeric OpenProvider SSL order ID, got: %s", lookup),
+			)
+			return
+		}
+		orderID = id
+	} else {
+		orders, err := ssl.ListOrders(d.client, &ssl.ListOrdersOptions{CommonNamePattern: lookup})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading SSL Order",
+				fmt.Sprintf("Could not list SSL orders for %s: %s", lookup, err.Error()),
+			)
+			return
+		}
+		if match := selectSSLOrderByCommonName(orders, lookup); match != nil {
+			orderID = match.ID
+		}
+	}
+
+	// List results may omit the certificate, so the order is always read by ID
+	var order *ssl.SSLOrder
+	if orderID != 0 {
+		var err error
+		order, err = ssl.GetOrder(d.client, orderID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading SSL Order",
+				fmt.Sprintf("Could not read SSL order %s: %s", lookup, err.Error()),
+			)
+			return
+		}
+	}
+
+	if order == nil || order.ID == 0 {
+		resp.Diagnostics.AddError(
+			"SSL Order Not Found",
+			fmt.Sprintf("SSL order %s not found", lookup),
+		)
+		return
+	}
+
+	state := mapSSLOrderToDataSourceModel(ctx, order, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// selectSSLOrderByCommonName returns the order for a common name. Certificates are
+// often ordered again for the same name, so the active order that expires last is
+// preferred, followed by the most recently placed order. Dates use the API's
+// "2006-01-02 15:04:05" format and compare as strings.
+func selectSSLOrderByCommonName(orders []ssl.SSLOrder, commonName string) *ssl.SSLOrder {
+	var best *ssl.SSLOrder
+	for i := range orders {
+		order := &orders[i]
+		if !strings.EqualFold(order.CommonName, commonName) {
+			continue
+		}
+		if best == nil {
+			best = order
+			continue
+		}
+
+		orderActive, bestActive := order.Status == ssl.StatusActive, best.Status == ssl.StatusActive
+		switch {
+		case orderActive != bestActive:
+			if orderActive {
+				best = order
+			}
+		case orderActive:
+			if order.ExpirationDate > best.ExpirationDate {
+				best = order
+			}
+		case order.OrderDate > best.OrderDate:
+			best = order
+		}
+	}
+	return best
+}
+
+// mapSSLOrderToDataSourceModel converts an SSL order API response to the data source model.
+func mapSSLOrderToDataSourceModel(ctx context.Context, order *ssl.SSLOrder, diags *diag.Diagnostics) SSLOrderDataSourceModel {
+	state := SSLOrderDataSourceModel{
+		ID:                     types.StringValue(strconv.Itoa(order.ID)),
+		CommonName:             types.StringValue(order.CommonName),
+		ProductID:              types.Int64Value(int64(order.ProductID)),
+		BrandName:              stringValueOrNull(order.BrandName),
+		Status:                 types.StringValue(order.Status),
+		OrderDate:              stringValueOrNull(order.OrderDate),
+		ActiveDate:             stringValueOrNull(order.ActiveDate),
+		ExpirationDate:         stringValueOrNull(order.ExpirationDate),
+		Autorenew:              types.BoolValue(order.Autorenew == "on"),
+		OwnerHandle:            stringValueOrNull(order.OwnerHandle),
+		AdminHandle:            stringValueOrNull(order.AdminHandle),
+		BillingHandle:          stringValueOrNull(order.BillingHandle),
+		TechnicalHandle:        stringValueOrNull(order.TechnicalHandle),
+		DomainValidationMethod: stringValueOrNull(order.DomainValidationMethod),
+	}
+
+	domainsVal, listDiags := types.ListValueFrom(ctx, types.StringType, order.AdditionalDomains)
+	diags.Append(listDiags...)
+	state.AdditionalDomains = domainsVal
+
+	cert := certificateFromOrder(ctx, order, diags)
+	state.CertificatePEM = cert.CertificatePEM
+	state.CABundlePEM = cert.CABundlePEM
+	state.FullChainPEM = cert.FullChainPEM
+	state.SerialNumber = cert.SerialNumber
+	state.NotBefore = cert.NotBefore
+	state.NotAfter = cert.NotAfter
+	state.FingerprintSHA256 = cert.FingerprintSHA256
+	state.Issuer = cert.Issuer
+	state.SubjectAltNames = cert.SubjectAltNames
+	return state
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSelectSSLOrderByCommonName(t *testing.T) {
+	testCases := []struct {
+		name   string
+		orders []ssl.SSLOrder
+		want   int
+	}{
+		{"no match", []ssl.SSLOrder{{ID: 1, CommonName: "other.com"}}, 0},
+		{"active expiring last", []ssl.SSLOrder{
+			{ID: 1, CommonName: "example.com", Status: ssl.StatusActive, ExpirationDate: "2027-01-01 00:00:00"},
+			{ID: 2, CommonName: "EXAMPLE.com", Status: ssl.StatusActive, ExpirationDate: "2028-01-01 00:00:00"},
+			{ID: 3, CommonName: "example.com", Status: ssl.StatusPending, OrderDate: "2026-10-01 00:00:00"},
+		}, 2},
+		{"most recent order", []ssl.SSLOrder{
+			{ID: 1, CommonName: "example.com", Status: ssl.StatusExpired, OrderDate: "2024-01-01 00:00:00"},
+			{ID: 2, CommonName: "example.com", Status: ssl.StatusPending, OrderDate: "2026-10-01 00:00:00"},
+			{ID: 3, CommonName: "www.example.com", Status: ssl.StatusActive},
+		}, 2},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := 0
+			if order := selectSSLOrderByCommonName(tc.orders, "example.com"); order != nil {
+				got = order.ID
+			}
+			if got != tc.want {
+				t.Errorf("Expected order %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+// sslOrdersAPI returns the routes of a fake API listing the given SSL orders and
+// serving their details, which include the certificate. The queries of list
+// requests are recorded in queries.
+func sslOrdersAPI(orders []ssl.SSLOrder, queries *[]string) fakeAPI {
+	return fakeAPI{
+		"GET /v1beta/ssl/orders": func(w http.ResponseWriter, r *http.Request) {
+			*queries = append(*queries, r.URL.RawQuery)
+			listed := make([]ssl.SSLOrder, 0, len(orders))
+			for _, order := range orders {
+				order.Certificate, order.CertificateCA = "", ""
+				listed = append(listed, order)
+			}
+			writeJSON(w, ssl.ListSSLOrdersResponse{
+				Data: ssl.ListSSLOrdersResponseData{Results: listed, Total: len(listed)},
+			})
+		},
+		"GET /v1beta/ssl/orders/{id}": func(w http.ResponseWriter, r *http.Request) {
+			for _, order := range orders {
+				if r.PathValue("id") == strconv.Itoa(order.ID) {
+					writeJSON(w, ssl.GetSSLOrderResponse{Data: order})
+					return
+				}
+			}
+			http.NotFound(w, r)
+		},
+	}
+}
+
+func TestSSLOrderDataSourceReadByCommonName(t *testing.T) {
+	ctx := context.Background()
+	var queries []string
+	c := newFakeAPIClient(t, sslOrdersAPI([]ssl.SSLOrder{
+		{ID: 1, CommonName: "example.com", Status: ssl.StatusExpired, OrderDate: "2024-01-01 00:00:00"},
+		{ID: 2, CommonName: "example.com", Status: ssl.StatusActive, ProductID: 7, ExpirationDate: "2027-01-01 00:00:00",
+			Autorenew: "on", AdditionalDomains: []string{"www.example.com"},
+			Certificate: testCertificatePEM(t, "example.com", "example.com", "www.example.com")},
+	}, &queries))
+	d := &SSLOrderDataSource{client: c}
+	config := dataSourceConfig(t, d, map[string]tftypes.Value{"common_name": tftypes.NewValue(tftypes.String, "example.com")})
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if len(queries) != 1 || !strings.Contains(queries[0], "common_name_pattern=example.com") {
+		t.Errorf("Expected the list to be filtered by common name, got %v", queries)
+	}
+
+	var state SSLOrderDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if state.ID.ValueString() != "2" || state.ProductID.ValueInt64() != 7 || !state.Autorenew.ValueBool() {
+		t.Errorf("Expected active order 2 of product 7 with autorenew, got %s, %d, %v", state.ID, state.ProductID.ValueInt64(), state.Autorenew)
+	}
+	if state.CertificatePEM.IsNull() || state.NotAfter.IsNull() || len(state.SubjectAltNames.Elements()) != 3 {
+		t.Errorf("Expected the certificate and its metadata, got %s with SANs %s", state.NotAfter, state.SubjectAltNames)
+	}
+
+	config = dataSourceConfig(t, d, map[string]tftypes.Value{"common_name": tftypes.NewValue(tftypes.String, "unknown.com")})
+	resp = &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "SSL Order Not Found" {
+		t.Errorf("Expected SSL Order Not Found, got %v", resp.Diagnostics)
+	}
+}
+
+func TestSSLOrderDataSourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	d := &SSLOrderDataSource{}
+
+	testCases := []struct {
+		name    string
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		{"id", map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "123")}, false},
+		{"common_name", map[string]tftypes.Value{"common_name": tftypes.NewValue(tftypes.String, "example.com")}, false},
+		{"neither", map[string]tftypes.Value{}, true},
+		{"both", map[string]tftypes.Value{
+			"id":          tftypes.NewValue(tftypes.String, "123"),
+			"common_name": tftypes.NewValue(tftypes.String, "example.com"),
+		}, true},
+		{"non-numeric id", map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "example.com")}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: dataSourceConfig(t, d, tc.values)}, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("Expected error %v, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &SSLOrdersDataSource{}
+	_ datasource.DataSourceWithConfigure      = &SSLOrdersDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &SSLOrdersDataSource{}
+)
+
+// SSLOrdersDataSource is the data source implementation.
+type SSLOrdersDataSource struct {
+	client *client.Client
+}
+
+// SSLOrdersDataSourceModel describes the data source data model.
+type SSLOrdersDataSourceModel struct {
+	ID                  types.String              `tfsdk:"id"`
+	Status              types.String              `tfsdk:"status"`
+	CommonNamePattern   types.String              `tfsdk:"common_name_pattern"`
+	ExpiresWithinDays   types.Int64               `tfsdk:"expires_within_days"`
+	IncludeCertificates types.Bool                `tfsdk:"include_certificates"`
+	Orders              []SSLOrderDataSourceModel `tfsdk:"orders"`
+}
+
+// NewSSLOrdersDataSource returns a new instance of the SSL orders data source.
+func NewSSLOrdersDataSource() datasource.DataSource {
+	return &SSLOrdersDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *SSLOrdersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_orders"
+}
+
+// Schema defines the schema for the data source.
+func (d *SSLOrdersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the SSL certificate orders in the OpenProvider account, optionally filtered by status, common name or upcoming expiry.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return orders with this status (e.g., `ACT`).",
+				Optional:            true,
+			},
+			"common_name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Only return orders whose common name matches this pattern. `*` matches any characters (e.g., `*.example.com`).",
+				Optional:            true,
+			},
+			"expires_within_days": schema.Int64Attribute{
+				MarkdownDescription: "Only return orders whose certificate expires within this number of days, including certificates that have already expired. Applied after the other filters.",
+				Optional:            true,
+			},
+			"include_certificates": schema.BoolAttribute{
+				MarkdownDescription: "Read every matching order to include its issued certificate and certificate metadata. " +
+					"This makes one API request per order. Defaults to `false`.",
+				Optional: true,
+			},
+			"orders": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching orders.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: sslOrderAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SSLOrdersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig rejects a negative expiry window.
+func (d *SSLOrdersDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var expiresWithinDays types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_within_days"), &expiresWithinDays)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !expiresWithinDays.IsNull() && !expiresWithinDays.IsUnknown() && expiresWithinDays.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_within_days"),
+			"Invalid Expiry Window",
+			fmt.Sprintf("expires_within_days must not be negative, got: %d", expiresWithinDays.ValueInt64()),
+		)
+	}
+}
+
+// Read retrieves the matching SSL orders.
+func (d *SSLOrdersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SSLOrdersDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := ssl.ListOrders(d.client, &ssl.ListOrdersOptions{
+		Status:            strings.ToUpper(config.Status.ValueString()),
+		CommonNamePattern: config.CommonNamePattern.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading SSL Orders",
+			fmt.Sprintf("Could not list SSL orders: %s", err.Error()),
+		)
+		return
+	}
+
+	// The expiry window is not supported by the API and is applied here
+	var expiresBefore time.Time
+	if !config.ExpiresWithinDays.IsNull() {
+		expiresBefore = time.Now().AddDate(0, 0, int(config.ExpiresWithinDays.ValueInt64()))
+	}
+
+	config.Orders = make([]SSLOrderDataSourceModel, 0, len(results))
+	for _, order := range results {
+		if !expiresBefore.IsZero() && !expiresBeforeDate(order.ExpirationDate, expiresBefore) {
+			continue
+		}
+
+		if config.IncludeCertificates.ValueBool() {
+			detailed, err := ssl.GetOrder(d.client, order.ID)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Reading SSL Order",
+					fmt.Sprintf("Could not read SSL order %d: %s", order.ID, err.Error()),
+				)
+				return
+			}
+			order = *detailed
+		}
+
+		config.Orders = append(config.Orders, mapSSLOrderToDataSourceModel(ctx, &order, &resp.Diagnostics))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue("ssl_orders")
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
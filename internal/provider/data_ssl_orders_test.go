@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSSLOrdersDataSourceReadFiltersByExpiry(t *testing.T) {
+	ctx := context.Background()
+	soon := time.Now().AddDate(0, 0, 10).Format("2006-01-02 15:04:05")
+	later := time.Now().AddDate(1, 0, 0).Format("2006-01-02 15:04:05")
+
+	var queries []string
+	c := newFakeAPIClient(t, sslOrdersAPI([]ssl.SSLOrder{
+		{ID: 1, CommonName: "soon.example.com", Status: ssl.StatusActive, ExpirationDate: soon,
+			Certificate: testCertificatePEM(t, "soon.example.com", "soon.example.com")},
+		{ID: 2, CommonName: "later.example.com", Status: ssl.StatusActive, ExpirationDate: later},
+		{ID: 3, CommonName: "pending.example.com", Status: ssl.StatusPending},
+	}, &queries))
+	d := &SSLOrdersDataSource{client: c}
+
+	for _, includeCertificates := range []bool{false, true} {
+		config := dataSourceConfig(t, d, map[string]tftypes.Value{
+			"status":               tftypes.NewValue(tftypes.String, "act"),
+			"common_name_pattern":  tftypes.NewValue(tftypes.String, "*.example.com"),
+			"expires_within_days":  tftypes.NewValue(tftypes.Number, 30),
+			"include_certificates": tftypes.NewValue(tftypes.Bool, includeCertificates),
+		})
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+		}
+
+		var state SSLOrdersDataSourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+		}
+
+		if len(state.Orders) != 1 || state.Orders[0].CommonName.ValueString() != "soon.example.com" {
+			t.Fatalf("Expected only soon.example.com within the expiry window, got %+v", state.Orders)
+		}
+		if state.Orders[0].CertificatePEM.IsNull() == includeCertificates {
+			t.Errorf("Expected certificate_pem to be set only with include_certificates, got %s", state.Orders[0].CertificatePEM)
+		}
+	}
+
+	if !strings.Contains(queries[0], "status=ACT") || !strings.Contains(queries[0], "common_name_pattern=%2A.example.com") {
+		t.Errorf("Expected server-side filters status=ACT and common_name_pattern=*.example.com, got %v", queries)
+	}
+}
@@ -4,20 +4,32 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 	ssllib "github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ datasource.DataSource              = &SSLProductDataSource{}
-	_ datasource.DataSourceWithConfigure = &SSLProductDataSource{}
+	_ datasource.DataSource                   = &SSLProductDataSource{}
+	_ datasource.DataSourceWithConfigure      = &SSLProductDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &SSLProductDataSource{}
 )
 
+// sslProductPriceAttrTypes defines the attribute types for SSL product prices.
+var sslProductPriceAttrTypes = map[string]attr.Type{
+	"period":       types.Int64Type,
+	"create_price": types.Float64Type,
+	"renew_price":  types.Float64Type,
+	"currency":     types.StringType,
+}
+
 // SSLProductDataSource is the data source implementation.
 type SSLProductDataSource struct {
 	client *client.Client
@@ -34,9 +46,22 @@ type SSLProductDataSourceModel struct {
 	Encryption      types.String `tfsdk:"encryption"`
 	FreeRefundDays  types.Int64  `tfsdk:"free_refund_days"`
 	FreeReissueDays types.Int64  `tfsdk:"free_reissue_days"`
+	Wildcard        types.Bool   `tfsdk:"wildcard"`
+	MultiDomain     types.Bool   `tfsdk:"multi_domain"`
+	MaxDomains      types.Int64  `tfsdk:"max_domains"`
+	MaxPeriod       types.Int64  `tfsdk:"max_period"`
+	Prices          types.List   `tfsdk:"prices"`
 	ID              types.String `tfsdk:"id"`
 }
 
+// SSLProductPriceModel describes the price of an SSL product for a period.
+type SSLProductPriceModel struct {
+	Period      types.Int64   `tfsdk:"period"`
+	CreatePrice types.Float64 `tfsdk:"create_price"`
+	RenewPrice  types.Float64 `tfsdk:"renew_price"`
+	Currency    types.String  `tfsdk:"currency"`
+}
+
 // NewSSLProductDataSource returns a new instance of the SSL product data source.
 func NewSSLProductDataSource() datasource.DataSource {
 	return &SSLProductDataSource{}
@@ -49,50 +74,108 @@ func (d *SSLProductDataSource) Metadata(_ context.Context, req datasource.Metada
 
 // Schema defines the schema for the data source.
 func (d *SSLProductDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := sslProductAttributes()
+	attributes["product_id"] = schema.Int64Attribute{
+		MarkdownDescription: "The SSL product ID to retrieve. Set either `product_id` or `name` to look up the product.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the SSL product to retrieve (case-insensitive). Set either `product_id` or `name` to look up the product.",
+		Optional:            true,
+		Computed:            true,
+	}
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Read information about an SSL/TLS certificate product.",
-		Attributes: map[string]schema.Attribute{
-			"product_id": schema.Int64Attribute{
-				MarkdownDescription: "The SSL product ID to retrieve.",
-				Required:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the SSL product.",
-				Computed:            true,
-			},
-			"brand_name": schema.StringAttribute{
-				MarkdownDescription: "The brand name of the SSL certificate (e.g., Comodo, Sectigo).",
-				Computed:            true,
-			},
-			"category": schema.StringAttribute{
-				MarkdownDescription: "The category of the SSL product (e.g., dv, ov, ev).",
-				Computed:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "A description of the SSL product.",
-				Computed:            true,
-			},
-			"delivery_time": schema.StringAttribute{
-				MarkdownDescription: "The estimated delivery time for the SSL certificate.",
-				Computed:            true,
-			},
-			"encryption": schema.StringAttribute{
-				MarkdownDescription: "The encryption strength (e.g., 256-bit).",
-				Computed:            true,
-			},
-			"free_refund_days": schema.Int64Attribute{
-				MarkdownDescription: "Number of days for free refund after purchase.",
-				Computed:            true,
-			},
-			"free_reissue_days": schema.Int64Attribute{
-				MarkdownDescription: "Number of days for free reissue after purchase.",
-				Computed:            true,
-			},
-			"id": schema.StringAttribute{
-				MarkdownDescription: "The product identifier.",
-				Computed:            true,
+		Attributes:          attributes,
+	}
+}
+
+// sslProductAttributes returns the computed attributes describing an SSL product,
+// shared by the openprovider_ssl_product and openprovider_ssl_products data sources.
+func sslProductAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"product_id": schema.Int64Attribute{
+			MarkdownDescription: "The SSL product ID.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the SSL product.",
+			Computed:            true,
+		},
+		"brand_name": schema.StringAttribute{
+			MarkdownDescription: "The brand name of the SSL certificate (e.g., Comodo, Sectigo).",
+			Computed:            true,
+		},
+		"category": schema.StringAttribute{
+			MarkdownDescription: "The category of the SSL product (e.g., dv, ov, ev).",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A description of the SSL product.",
+			Computed:            true,
+		},
+		"delivery_time": schema.StringAttribute{
+			MarkdownDescription: "The estimated delivery time for the SSL certificate.",
+			Computed:            true,
+		},
+		"encryption": schema.StringAttribute{
+			MarkdownDescription: "The encryption strength (e.g., 256-bit).",
+			Computed:            true,
+		},
+		"free_refund_days": schema.Int64Attribute{
+			MarkdownDescription: "Number of days for free refund after purchase.",
+			Computed:            true,
+		},
+		"free_reissue_days": schema.Int64Attribute{
+			MarkdownDescription: "Number of days for free reissue after purchase.",
+			Computed:            true,
+		},
+		"wildcard": schema.BoolAttribute{
+			MarkdownDescription: "Whether the product can secure wildcard domains.",
+			Computed:            true,
+		},
+		"multi_domain": schema.BoolAttribute{
+			MarkdownDescription: "Whether the product can secure more than one domain (SANs).",
+			Computed:            true,
+		},
+		"max_domains": schema.Int64Attribute{
+			MarkdownDescription: "The number of domains, including the common name, a certificate can be issued for.",
+			Computed:            true,
+		},
+		"max_period": schema.Int64Attribute{
+			MarkdownDescription: "The longest validity period in years.",
+			Computed:            true,
+		},
+		"prices": schema.ListNestedAttribute{
+			MarkdownDescription: "The prices charged to the account per period.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"period": schema.Int64Attribute{
+						MarkdownDescription: "The period in years.",
+						Computed:            true,
+					},
+					"create_price": schema.Float64Attribute{
+						MarkdownDescription: "The price of ordering a certificate for the period.",
+						Computed:            true,
+					},
+					"renew_price": schema.Float64Attribute{
+						MarkdownDescription: "The price of renewing a certificate for the period.",
+						Computed:            true,
+					},
+					"currency": schema.StringAttribute{
+						MarkdownDescription: "The currency of the prices.",
+						Computed:            true,
+					},
+				},
 			},
 		},
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The product identifier.",
+			Computed:            true,
+		},
 	}
 }
 
@@ -114,6 +197,22 @@ func (d *SSLProductDataSource) Configure(_ context.Context, req datasource.Confi
 	d.client = client
 }
 
+// ValidateConfig checks that the product is looked up either by ID or by name.
+func (d *SSLProductDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config SSLProductDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.ProductID.IsUnknown() || config.Name.IsUnknown() {
+		return
+	}
+
+	if config.ProductID.IsNull() == config.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid SSL Product Lookup",
+			"Exactly one of product_id or name must be set to look up an SSL product.",
+		)
+	}
+}
+
 // Read is called when the provider must read data source values in order to update state.
 func (d *SSLProductDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var config SSLProductDataSourceModel
@@ -123,28 +222,103 @@ func (d *SSLProductDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	productID := int(config.ProductID.ValueInt64())
-
-	product, err := ssllib.GetProduct(d.client, productID)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading SSL product",
-			fmt.Sprintf("Could not read SSL product: %s", err.Error()),
-		)
-		return
+	var product *ssllib.SSLProduct
+	if !config.ProductID.IsNull() {
+		var err error
+		product, err = ssllib.GetProduct(d.client, int(config.ProductID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading SSL product",
+				fmt.Sprintf("Could not read SSL product: %s", err.Error()),
+			)
+			return
+		}
+	} else {
+		product = d.findProductByName(config.Name.ValueString(), &resp.Diagnostics)
+		if product == nil {
+			return
+		}
 	}
 
 	// Map response to state
-	config.Name = types.StringValue(product.Name)
-	config.BrandName = types.StringValue(product.BrandName)
-	config.Category = types.StringValue(product.Category)
-	config.Description = types.StringValue(product.Description)
-	config.DeliveryTime = types.StringValue(product.DeliveryTime)
-	config.Encryption = types.StringValue(product.Encryption)
-	config.FreeRefundDays = types.Int64Value(int64(product.FreeRefundDays))
-	config.FreeReissueDays = types.Int64Value(int64(product.FreeReissueDays))
-	config.ID = types.StringValue(fmt.Sprintf("%d", product.ID))
+	mapSSLProductToModel(ctx, product, &config, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, &config)
 	resp.Diagnostics.Append(diags...)
 }
+
+// findProductByName returns the product with the given name, adding an error when
+// no product or more than one product matches.
+func (d *SSLProductDataSource) findProductByName(name string, diags *diag.Diagnostics) *ssllib.SSLProduct {
+	products, err := ssllib.ListProducts(d.client)
+	if err != nil {
+		diags.AddError(
+			"Error listing SSL products",
+			fmt.Sprintf("Could not list SSL products to look up %q: %s", name, err.Error()),
+		)
+		return nil
+	}
+
+	var matches []ssllib.SSLProduct
+	for _, product := range products {
+		if strings.EqualFold(product.Name, name) {
+			matches = append(matches, product)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		diags.AddError(
+			"SSL Product Not Found",
+			fmt.Sprintf("No SSL product is named %q.", name),
+		)
+		return nil
+	case 1:
+		return &matches[0]
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, product := range matches {
+		ids = append(ids, fmt.Sprintf("%d", product.ID))
+	}
+	diags.AddError(
+		"Multiple SSL Products Found",
+		fmt.Sprintf("%d SSL products are named %q (IDs %s). Use product_id to select one.", len(matches), name, strings.Join(ids, ", ")),
+	)
+	return nil
+}
+
+// mapSSLProductToModel maps an SSL product to the data source model.
+func mapSSLProductToModel(ctx context.Context, product *ssllib.SSLProduct, model *SSLProductDataSourceModel, diags *diag.Diagnostics) {
+	model.ProductID = types.Int64Value(int64(product.ID))
+	model.Name = types.StringValue(product.Name)
+	model.BrandName = types.StringValue(product.BrandName)
+	model.Category = types.StringValue(product.Category)
+	model.Description = types.StringValue(product.Description)
+	model.DeliveryTime = types.StringValue(product.DeliveryTime)
+	model.Encryption = types.StringValue(product.Encryption)
+	model.FreeRefundDays = types.Int64Value(int64(product.FreeRefundDays))
+	model.FreeReissueDays = types.Int64Value(int64(product.FreeReissueDays))
+	model.Wildcard = types.BoolValue(product.IsWildcardSupported)
+	model.MultiDomain = types.BoolValue(product.MaxDomains > 1)
+	model.MaxDomains = types.Int64Value(int64(product.MaxDomains))
+	model.MaxPeriod = types.Int64Value(int64(product.MaxPeriod))
+	model.ID = types.StringValue(fmt.Sprintf("%d", product.ID))
+
+	prices := make([]SSLProductPriceModel, 0, len(product.Prices))
+	for _, price := range product.Prices {
+		currency := price.Create.Reseller.Currency
+		if currency == "" {
+			currency = price.Renew.Reseller.Currency
+		}
+		prices = append(prices, SSLProductPriceModel{
+			Period:      types.Int64Value(int64(price.Period)),
+			CreatePrice: types.Float64Value(price.Create.Reseller.Price),
+			RenewPrice:  types.Float64Value(price.Renew.Reseller.Price),
+			Currency:    types.StringValue(currency),
+		})
+	}
+	pricesValue, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: sslProductPriceAttrTypes}, prices)
+	diags.Append(listDiags...)
+	model.Prices = pricesValue
+}
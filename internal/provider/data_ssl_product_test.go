@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testSSLProductPrices returns reseller prices for a product per period.
+func testSSLProductPrices(prices map[int]float64) []ssl.ProductPrice {
+	var result []ssl.ProductPrice
+	for period := 1; period <= len(prices); period++ {
+		amount := ssl.Price{Currency: "EUR", Price: prices[period]}
+		result = append(result, ssl.ProductPrice{
+			Period: period,
+			Create: ssl.PriceAmounts{Reseller: amount},
+			Renew:  ssl.PriceAmounts{Reseller: amount},
+		})
+	}
+	return result
+}
+
+// sslProductsAPI returns the routes of a fake API listing the given SSL products.
+func sslProductsAPI(products []ssl.SSLProduct) fakeAPI {
+	return fakeAPI{
+		"GET /v1beta/ssl/products": func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(w, ssl.ListSSLProductsResponse{
+				Data: ssl.ListSSLProductsResponseData{Results: products, Total: len(products)},
+			})
+		},
+	}
+}
+
+func TestSSLProductDataSourceReadByName(t *testing.T) {
+	ctx := context.Background()
+	c := newFakeAPIClient(t, sslProductsAPI([]ssl.SSLProduct{
+		{ID: 1, Name: "PositiveSSL", BrandName: "Sectigo", Category: "dv", MaxDomains: 1, MaxPeriod: 2,
+			Prices: testSSLProductPrices(map[int]float64{1: 7.5, 2: 14})},
+		{ID: 2, Name: "PositiveSSL Wildcard", BrandName: "Sectigo", Category: "dv", IsWildcardSupported: true},
+		{ID: 3, Name: "Duplicate"},
+		{ID: 4, Name: "duplicate"},
+	}))
+	d := &SSLProductDataSource{client: c}
+
+	config := dataSourceConfig(t, d, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "positivessl")})
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var state SSLProductDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if state.ProductID.ValueInt64() != 1 || state.Name.ValueString() != "PositiveSSL" || state.MultiDomain.ValueBool() {
+		t.Errorf("Expected single-domain product 1 PositiveSSL, got %d %s", state.ProductID.ValueInt64(), state.Name)
+	}
+
+	var prices []SSLProductPriceModel
+	resp.Diagnostics.Append(state.Prices.ElementsAs(ctx, &prices, false)...)
+	if len(prices) != 2 || prices[1].Period.ValueInt64() != 2 || prices[1].CreatePrice.ValueFloat64() != 14 || prices[1].Currency.ValueString() != "EUR" {
+		t.Errorf("Expected prices for 1 and 2 years, got %+v", prices)
+	}
+
+	for name, summary := range map[string]string{
+		"Duplicate": "Multiple SSL Products Found",
+		"Unknown":   "SSL Product Not Found",
+	} {
+		config := dataSourceConfig(t, d, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name)})
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != summary {
+			t.Errorf("Expected %q for %s, got %v", summary, name, resp.Diagnostics)
+		}
+	}
+}
+
+func TestSSLProductDataSourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	d := &SSLProductDataSource{}
+
+	testCases := []struct {
+		name    string
+		values  map[string]tftypes.Value
+		wantErr bool
+	}{
+		{"product_id", map[string]tftypes.Value{"product_id": tftypes.NewValue(tftypes.Number, 1)}, false},
+		{"name", map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "PositiveSSL")}, false},
+		{"neither", map[string]tftypes.Value{}, true},
+		{"both", map[string]tftypes.Value{
+			"product_id": tftypes.NewValue(tftypes.Number, 1),
+			"name":       tftypes.NewValue(tftypes.String, "PositiveSSL"),
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: dataSourceConfig(t, d, tc.values)}, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Errorf("Expected error %v, got %v", tc.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
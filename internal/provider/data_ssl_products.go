@@ -0,0 +1,232 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	ssllib "github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &SSLProductsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &SSLProductsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &SSLProductsDataSource{}
+)
+
+// SSLProductsDataSource is the data source implementation.
+type SSLProductsDataSource struct {
+	client *client.Client
+}
+
+// SSLProductsDataSourceModel describes the data source data model.
+type SSLProductsDataSourceModel struct {
+	ID          types.String                `tfsdk:"id"`
+	BrandName   types.String                `tfsdk:"brand_name"`
+	Category    types.String                `tfsdk:"category"`
+	Wildcard    types.Bool                  `tfsdk:"wildcard"`
+	MultiDomain types.Bool                  `tfsdk:"multi_domain"`
+	MinDomains  types.Int64                 `tfsdk:"min_domains"`
+	Period      types.Int64                 `tfsdk:"period"`
+	Products    []SSLProductDataSourceModel `tfsdk:"products"`
+}
+
+// NewSSLProductsDataSource returns a new instance of the SSL products data source.
+func NewSSLProductsDataSource() datasource.DataSource {
+	return &SSLProductsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *SSLProductsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_products"
+}
+
+// Schema defines the schema for the data source.
+func (d *SSLProductsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the SSL/TLS certificate products, optionally filtered by brand, validation level and features. " +
+			"Products are sorted by price, cheapest first.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Placeholder identifier for the data source.",
+				Computed:            true,
+			},
+			"brand_name": schema.StringAttribute{
+				MarkdownDescription: "Only return products of this brand (e.g., `Sectigo`), case-insensitive.",
+				Optional:            true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Only return products of this validation level (`dv`, `ov` or `ev`), case-insensitive.",
+				Optional:            true,
+			},
+			"wildcard": schema.BoolAttribute{
+				MarkdownDescription: "Only return products that do, or do not, support wildcard domains.",
+				Optional:            true,
+			},
+			"multi_domain": schema.BoolAttribute{
+				MarkdownDescription: "Only return products that do, or do not, support more than one domain.",
+				Optional:            true,
+			},
+			"min_domains": schema.Int64Attribute{
+				MarkdownDescription: "Only return products that allow at least this many domains per certificate, including the common name.",
+				Optional:            true,
+			},
+			"period": schema.Int64Attribute{
+				MarkdownDescription: "Only return products that can be ordered for this period in years. Products are then sorted by their price for this period.",
+				Optional:            true,
+			},
+			"products": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching products.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: sslProductAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *SSLProductsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig checks the numeric filters.
+func (d *SSLProductsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config SSLProductsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.MinDomains.IsNull() && !config.MinDomains.IsUnknown() && config.MinDomains.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("min_domains"),
+			"Invalid Domain Count",
+			fmt.Sprintf("min_domains must be at least 1, got: %d", config.MinDomains.ValueInt64()),
+		)
+	}
+	if !config.Period.IsNull() && !config.Period.IsUnknown() && config.Period.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("period"),
+			"Invalid Period",
+			fmt.Sprintf("period must be at least 1, got: %d", config.Period.ValueInt64()),
+		)
+	}
+}
+
+// Read lists the SSL products and applies the filters.
+func (d *SSLProductsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config SSLProductsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	products, err := ssllib.ListProducts(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error listing SSL products",
+			fmt.Sprintf("Could not list SSL products: %s", err.Error()),
+		)
+		return
+	}
+
+	period := int(config.Period.ValueInt64())
+
+	var matches []ssllib.SSLProduct
+	for _, product := range products {
+		if sslProductMatches(product, config) {
+			matches = append(matches, product)
+		}
+	}
+
+	// Cheapest first; products without a price for the period are listed last
+	sort.SliceStable(matches, func(i, j int) bool {
+		return sslProductCreatePrice(matches[i], period) < sslProductCreatePrice(matches[j], period)
+	})
+
+	config.Products = make([]SSLProductDataSourceModel, 0, len(matches))
+	for i := range matches {
+		var model SSLProductDataSourceModel
+		mapSSLProductToModel(ctx, &matches[i], &model, &resp.Diagnostics)
+		config.Products = append(config.Products, model)
+	}
+	config.ID = types.StringValue("ssl_products")
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
+
+// sslProductMatches reports whether a product passes the configured filters.
+func sslProductMatches(product ssllib.SSLProduct, config SSLProductsDataSourceModel) bool {
+	if !config.BrandName.IsNull() && !strings.EqualFold(product.BrandName, config.BrandName.ValueString()) {
+		return false
+	}
+	if !config.Category.IsNull() && !strings.EqualFold(product.Category, config.Category.ValueString()) {
+		return false
+	}
+	if !config.Wildcard.IsNull() && product.IsWildcardSupported != config.Wildcard.ValueBool() {
+		return false
+	}
+	if !config.MultiDomain.IsNull() && (product.MaxDomains > 1) != config.MultiDomain.ValueBool() {
+		return false
+	}
+	if !config.MinDomains.IsNull() && int64(product.MaxDomains) < config.MinDomains.ValueInt64() {
+		return false
+	}
+	if !config.Period.IsNull() {
+		period := int(config.Period.ValueInt64())
+		if len(product.Prices) == 0 {
+			return product.MaxPeriod >= period
+		}
+		for _, price := range product.Prices {
+			if price.Period == period {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// sslProductCreatePrice returns the price of ordering a product for the period, or
+// for its shortest period when period is 0. Products without a matching price sort
+// last.
+func sslProductCreatePrice(product ssllib.SSLProduct, period int) float64 {
+	price := math.Inf(1)
+	shortest := math.MaxInt
+	for _, p := range product.Prices {
+		switch {
+		case period > 0 && p.Period == period:
+			return p.Create.Reseller.Price
+		case period == 0 && p.Period < shortest:
+			shortest = p.Period
+			price = p.Create.Reseller.Price
+		}
+	}
+	return price
+}
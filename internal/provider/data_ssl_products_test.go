@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSSLProductsDataSourceRead(t *testing.T) {
+	ctx := context.Background()
+	c := newFakeAPIClient(t, sslProductsAPI([]ssl.SSLProduct{
+		{ID: 1, Name: "PositiveSSL Wildcard", BrandName: "Sectigo", Category: "dv", IsWildcardSupported: true, MaxDomains: 1,
+			Prices: testSSLProductPrices(map[int]float64{1: 60, 2: 110})},
+		{ID: 2, Name: "EssentialSSL Wildcard", BrandName: "sectigo", Category: "DV", IsWildcardSupported: true, MaxDomains: 1,
+			Prices: testSSLProductPrices(map[int]float64{1: 45, 2: 120})},
+		{ID: 3, Name: "PositiveSSL", BrandName: "Sectigo", Category: "dv", MaxDomains: 1,
+			Prices: testSSLProductPrices(map[int]float64{1: 7.5, 2: 14})},
+		{ID: 4, Name: "RapidSSL Wildcard", BrandName: "DigiCert", Category: "dv", IsWildcardSupported: true, MaxDomains: 1,
+			Prices: testSSLProductPrices(map[int]float64{1: 30})},
+		{ID: 5, Name: "InstantSSL Wildcard", BrandName: "Sectigo", Category: "ov", IsWildcardSupported: true, MaxDomains: 1},
+		{ID: 6, Name: "PositiveSSL Multi-Domain Wildcard", BrandName: "Sectigo", Category: "dv", IsWildcardSupported: true, MaxDomains: 250, MaxPeriod: 2},
+	}))
+	d := &SSLProductsDataSource{client: c}
+
+	testCases := []struct {
+		name   string
+		values map[string]tftypes.Value
+		want   []int64
+	}{
+		{"sectigo dv wildcard", map[string]tftypes.Value{
+			"brand_name": tftypes.NewValue(tftypes.String, "SECTIGO"),
+			"category":   tftypes.NewValue(tftypes.String, "dv"),
+			"wildcard":   tftypes.NewValue(tftypes.Bool, true),
+		}, []int64{2, 1, 6}},
+		{"two years", map[string]tftypes.Value{
+			"brand_name": tftypes.NewValue(tftypes.String, "sectigo"),
+			"category":   tftypes.NewValue(tftypes.String, "dv"),
+			"wildcard":   tftypes.NewValue(tftypes.Bool, true),
+			"period":     tftypes.NewValue(tftypes.Number, 2),
+		}, []int64{1, 2, 6}},
+		{"multi-domain", map[string]tftypes.Value{
+			"multi_domain": tftypes.NewValue(tftypes.Bool, true),
+			"min_domains":  tftypes.NewValue(tftypes.Number, 100),
+		}, []int64{6}},
+		{"single domain", map[string]tftypes.Value{
+			"wildcard":     tftypes.NewValue(tftypes.Bool, false),
+			"multi_domain": tftypes.NewValue(tftypes.Bool, false),
+		}, []int64{3}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := dataSourceConfig(t, d, tc.values)
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+
+			var state SSLProductsDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+
+			var got []int64
+			for _, product := range state.Products {
+				got = append(got, product.ProductID.ValueInt64())
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tc.want) {
+				t.Errorf("Expected products %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &TLDDataSource{}
+	_ datasource.DataSourceWithConfigure = &TLDDataSource{}
+)
+
+// TLDDataSource is the data source implementation.
+type TLDDataSource struct {
+	client *client.Client
+}
+
+// TLDModel describes the registry capabilities of a TLD.
+type TLDModel struct {
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	Status                 types.String `tfsdk:"status"`
+	MinPeriod              types.Int64  `tfsdk:"min_period"`
+	MaxPeriod              types.Int64  `tfsdk:"max_period"`
+	RenewAvailable         types.Bool   `tfsdk:"renew_available"`
+	MaxRenewPeriod         types.Int64  `tfsdk:"max_renew_period"`
+	TransferAvailable      types.Bool   `tfsdk:"transfer_available"`
+	AuthCodeRequired       types.Bool   `tfsdk:"auth_code_required"`
+	DnssecSupported        types.Bool   `tfsdk:"dnssec_supported"`
+	IDNSupported           types.Bool   `tfsdk:"idn_supported"`
+	WhoisPrivacySupported  types.Bool   `tfsdk:"whois_privacy_supported"`
+	LockSupported          types.Bool   `tfsdk:"lock_supported"`
+	OwnerChangeIsTrade     types.Bool   `tfsdk:"owner_change_is_trade"`
+	RequiredAdditionalData types.List   `tfsdk:"required_additional_data"`
+}
+
+// TLDDataSourceModel describes the data source data model.
+type TLDDataSourceModel struct {
+	ID types.String `tfsdk:"id"`
+	TLDModel
+}
+
+// NewTLDDataSource returns a new instance of the TLD data source.
+func NewTLDDataSource() datasource.DataSource {
+	return &TLDDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *TLDDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tld"
+}
+
+// Schema defines the schema for the data source.
+func (d *TLDDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := tldAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The TLD identifier (extension without the leading dot).",
+		Computed:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The extension to look up, with or without the leading dot (e.g., `com` or `.co.uk`).",
+		Required:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the registry capabilities of a TLD, such as the allowed registration periods and DNSSEC, WHOIS privacy and lock support.",
+		Attributes:          attributes,
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TLDDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the TLD information.
+func (d *TLDDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TLDDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := strings.ToLower(strings.TrimPrefix(config.Name.ValueString(), "."))
+
+	tld, err := tlds.Get(d.client, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading TLD",
+			fmt.Sprintf("Could not read TLD %s: %s", name, err.Error()),
+		)
+		return
+	}
+
+	var state TLDDataSourceModel
+	state.TLDModel = mapTLDToModel(ctx, tld, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = types.StringValue(name)
+	state.Name = config.Name
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// tldAttributes returns the computed attributes describing a TLD, shared by the
+// openprovider_tld and openprovider_tlds data sources.
+func tldAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The extension without the leading dot (e.g., `com`).",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A description of the TLD.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The availability status of the TLD at OpenProvider (e.g., `ACT`).",
+			Computed:            true,
+		},
+		"min_period": schema.Int64Attribute{
+			MarkdownDescription: "The minimum registration period in years.",
+			Computed:            true,
+		},
+		"max_period": schema.Int64Attribute{
+			MarkdownDescription: "The maximum registration period in years.",
+			Computed:            true,
+		},
+		"renew_available": schema.BoolAttribute{
+			MarkdownDescription: "Whether domains under this TLD can be renewed explicitly.",
+			Computed:            true,
+		},
+		"max_renew_period": schema.Int64Attribute{
+			MarkdownDescription: "The maximum renewal period in years, when limited by the registry.",
+			Computed:            true,
+		},
+		"transfer_available": schema.BoolAttribute{
+			MarkdownDescription: "Whether domains under this TLD can be transferred to OpenProvider.",
+			Computed:            true,
+		},
+		"auth_code_required": schema.BoolAttribute{
+			MarkdownDescription: "Whether an auth code is required to transfer domains under this TLD.",
+			Computed:            true,
+		},
+		"dnssec_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether the registry accepts DNSSEC keys.",
+			Computed:            true,
+		},
+		"idn_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether internationalized domain names are supported.",
+			Computed:            true,
+		},
+		"whois_privacy_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether WHOIS privacy protection is permitted.",
+			Computed:            true,
+		},
+		"lock_supported": schema.BoolAttribute{
+			MarkdownDescription: "Whether domains can be locked against transfers.",
+			Computed:            true,
+		},
+		"owner_change_is_trade": schema.BoolAttribute{
+			MarkdownDescription: "Whether the registry handles owner changes as a paid trade rather than a free registrant change.",
+			Computed:            true,
+		},
+		"required_additional_data": schema.ListAttribute{
+			MarkdownDescription: "The additional data fields the registry requires to register a domain.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+	}
+}
+
+// mapTLDToModel converts a TLD API response to a TLDModel.
+func mapTLDToModel(ctx context.Context, tld *tlds.TLD, diags *diag.Diagnostics) TLDModel {
+	requiredAdditionalData, listDiags := types.ListValueFrom(ctx, types.StringType, tld.RequiredAdditionalData)
+	diags.Append(listDiags...)
+
+	model := TLDModel{
+		Name:                   types.StringValue(tld.Name),
+		Description:            types.StringValue(tld.Description),
+		Status:                 types.StringValue(tld.Status),
+		MinPeriod:              types.Int64Value(int64(tld.MinPeriod)),
+		MaxPeriod:              types.Int64Value(int64(tld.MaxPeriod)),
+		RenewAvailable:         types.BoolValue(tld.RenewAvailable),
+		MaxRenewPeriod:         types.Int64Null(),
+		TransferAvailable:      types.BoolValue(tld.TransferAvailable),
+		AuthCodeRequired:       types.BoolValue(tld.IsTransferAuthCodeRequired),
+		DnssecSupported:        types.BoolValue(tld.DnssecAllowed),
+		IDNSupported:           types.BoolValue(tld.IsIDNAllowed),
+		WhoisPrivacySupported:  types.BoolValue(tld.IsPrivateWhoisAllowed),
+		LockSupported:          types.BoolValue(tld.IsLockable),
+		OwnerChangeIsTrade:     types.BoolValue(tld.IsTradeRequired),
+		RequiredAdditionalData: requiredAdditionalData,
+	}
+	if tld.MaxRenewPeriod > 0 {
+		model.MaxRenewPeriod = types.Int64Value(int64(tld.MaxRenewPeriod))
+	}
+
+	return model
+}
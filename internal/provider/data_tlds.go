@@ -0,0 +1,132 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &TLDsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TLDsDataSource{}
+)
+
+// TLDsDataSource is the data source implementation.
+type TLDsDataSource struct {
+	client *client.Client
+}
+
+// TLDsDataSourceModel describes the data source data model.
+type TLDsDataSourceModel struct {
+	ID    types.String `tfsdk:"id"`
+	Names types.List   `tfsdk:"names"`
+	TLDs  []TLDModel   `tfsdk:"tlds"`
+}
+
+// NewTLDsDataSource returns a new instance of the TLDs data source.
+func NewTLDsDataSource() datasource.DataSource {
+	return &TLDsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *TLDsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tlds"
+}
+
+// Schema defines the schema for the data source.
+func (d *TLDsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the TLDs offered by OpenProvider together with their registry capabilities.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier.",
+				Computed:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Only return these extensions, with or without the leading dot. All TLDs are returned when unset.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"tlds": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching TLDs.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: tldAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *TLDsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the TLD catalog.
+func (d *TLDsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config TLDsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	if !config.Names.IsNull() {
+		resp.Diagnostics.Append(config.Names.ElementsAs(ctx, &names, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for i, name := range names {
+			names[i] = strings.ToLower(strings.TrimPrefix(name, "."))
+		}
+	}
+
+	results, err := tlds.List(d.client)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading TLDs",
+			fmt.Sprintf("Could not list TLDs: %s", err.Error()),
+		)
+		return
+	}
+
+	config.TLDs = make([]TLDModel, 0, len(results))
+	for _, tld := range results {
+		if names != nil && !slices.Contains(names, strings.ToLower(tld.Name)) {
+			continue
+		}
+		config.TLDs = append(config.TLDs, mapTLDToModel(ctx, &tld, &resp.Diagnostics))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.ID = types.StringValue("tlds")
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,151 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &UnverifiedDomainsDataSource{}
+	_ datasource.DataSourceWithConfigure = &UnverifiedDomainsDataSource{}
+)
+
+// UnverifiedDomainsDataSource is the data source implementation.
+type UnverifiedDomainsDataSource struct {
+	client *client.Client
+}
+
+// UnverifiedDomainModel describes a domain whose owner has not verified their email address.
+type UnverifiedDomainModel struct {
+	Domain         types.String `tfsdk:"domain"`
+	Email          types.String `tfsdk:"email"`
+	Status         types.String `tfsdk:"status"`
+	IsSuspended    types.Bool   `tfsdk:"is_suspended"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+}
+
+// UnverifiedDomainsDataSourceModel describes the data source data model.
+type UnverifiedDomainsDataSourceModel struct {
+	ID      types.String            `tfsdk:"id"`
+	Email   types.String            `tfsdk:"email"`
+	Domains []UnverifiedDomainModel `tfsdk:"domains"`
+}
+
+// NewUnverifiedDomainsDataSource returns a new instance of the unverified domains data source.
+func NewUnverifiedDomainsDataSource() datasource.DataSource {
+	return &UnverifiedDomainsDataSource{}
+}
+
+// Metadata returns the data source type name.
+func (d *UnverifiedDomainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unverified_domains"
+}
+
+// Schema defines the schema for the data source.
+func (d *UnverifiedDomainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists domains whose owner has not completed the ICANN email verification. Registries suspend these domains once the verification period expires.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The data source identifier.",
+				Computed:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Only return domains awaiting verification of this email address.",
+				Optional:            true,
+			},
+			"domains": schema.ListNestedAttribute{
+				MarkdownDescription: "The domains awaiting owner verification.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The domain name.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The email address that must be verified.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The verification status: `in_progress`, `not_verified` or `failed`.",
+							Computed:            true,
+						},
+						"is_suspended": schema.BoolAttribute{
+							MarkdownDescription: "Whether the domain has been suspended because the verification was not completed in time.",
+							Computed:            true,
+						},
+						"expiration_date": schema.StringAttribute{
+							MarkdownDescription: "The date the verification period ends and the domain is suspended.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *UnverifiedDomainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read retrieves the domains awaiting owner verification.
+func (d *UnverifiedDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config UnverifiedDomainsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	verifications, err := customers.ListEmailVerifications(d.client, config.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Email Verifications",
+			fmt.Sprintf("Could not list owner email verifications: %s", err.Error()),
+		)
+		return
+	}
+
+	config.Domains = make([]UnverifiedDomainModel, 0, len(verifications))
+	for _, verification := range verifications {
+		if verification.Status == customers.VerificationStatusVerified {
+			continue
+		}
+		config.Domains = append(config.Domains, UnverifiedDomainModel{
+			Domain:         types.StringValue(verification.Domain),
+			Email:          types.StringValue(verification.Email),
+			Status:         types.StringValue(ownerVerificationStatus(&verification)),
+			IsSuspended:    types.BoolValue(verification.IsSuspended),
+			ExpirationDate: stringValueOrNull(verification.ExpirationDate),
+		})
+	}
+
+	config.ID = types.StringValue("unverified_domains")
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+}
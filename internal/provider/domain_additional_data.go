@@ -0,0 +1,121 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// additionalDataAttrTypes defines the attribute types for domain additional data.
+var additionalDataAttrTypes = map[string]attr.Type{
+	"trustee_service":     types.BoolType,
+	"nexus_category":      types.StringType,
+	"application_purpose": types.StringType,
+	"legal_type":          types.StringType,
+	"entity_type":         types.StringType,
+	"id_number":           types.StringType,
+}
+
+// additionalDataAllowedValues lists the accepted values of enumerated additional_data attributes.
+var additionalDataAllowedValues = map[string][]string{
+	"nexus_category":      {"C11", "C12", "C21", "C31", "C32"},
+	"application_purpose": {"P1", "P2", "P3", "P4", "P5"},
+	"legal_type": {
+		"ABO", "ASS", "CCO", "CCT", "EDU", "GOV", "HOP", "INB", "LAM",
+		"LGR", "MAJ", "OMK", "PLT", "PRT", "RES", "TDM", "TRD", "TRS",
+	},
+	"entity_type": {"1", "2", "3", "4", "5", "6", "7"},
+}
+
+// convertAdditionalDataToAPI converts Terraform additional data to the API format.
+func convertAdditionalDataToAPI(ctx context.Context, data types.Object, diags *diag.Diagnostics) *domains.AdditionalData {
+	if data.IsNull() || data.IsUnknown() {
+		return nil
+	}
+
+	var model DomainAdditionalDataModel
+	diags.Append(data.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil
+	}
+
+	return &domains.AdditionalData{
+		UseDomicile:        model.TrusteeService.ValueBoolPointer(),
+		NexusCategory:      model.NexusCategory.ValueString(),
+		ApplicationPurpose: model.ApplicationPurpose.ValueString(),
+		LegalType:          model.LegalType.ValueString(),
+		EntityType:         model.EntityType.ValueString(),
+		IDNumber:           model.IDNumber.ValueString(),
+	}
+}
+
+// mapAdditionalDataToState converts API additional data to Terraform state.
+// Empty strings are mapped to null so unset attributes do not produce a diff;
+// trustee_service is mapped as reported.
+func mapAdditionalDataToState(ctx context.Context, data *domains.AdditionalData, diags *diag.Diagnostics) types.Object {
+	if data == nil || *data == (domains.AdditionalData{}) {
+		return types.ObjectNull(additionalDataAttrTypes)
+	}
+
+	model := DomainAdditionalDataModel{
+		TrusteeService:     types.BoolPointerValue(data.UseDomicile),
+		NexusCategory:      stringValueOrNull(data.NexusCategory),
+		ApplicationPurpose: stringValueOrNull(data.ApplicationPurpose),
+		LegalType:          stringValueOrNull(data.LegalType),
+		EntityType:         stringValueOrNull(data.EntityType),
+		IDNumber:           stringValueOrNull(data.IDNumber),
+	}
+	objectValue, objectDiags := types.ObjectValueFrom(ctx, additionalDataAttrTypes, model)
+	diags.Append(objectDiags...)
+	return objectValue
+}
+
+// validateAdditionalData checks the values of enumerated additional_data
+// attributes. Which attributes a registry requires is taken from the TLD catalog
+// in ModifyPlan.
+func validateAdditionalData(data types.Object, diags *diag.Diagnostics) {
+	if data.IsNull() || data.IsUnknown() {
+		return
+	}
+
+	attributes := data.Attributes()
+
+	// Iterate in a stable order for deterministic diagnostics
+	names := make([]string, 0, len(additionalDataAllowedValues))
+	for name := range additionalDataAllowedValues {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		str, ok := attributes[name].(types.String)
+		if !ok || str.IsNull() || str.IsUnknown() {
+			continue
+		}
+
+		if allowed := additionalDataAllowedValues[name]; !slices.Contains(allowed, str.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("additional_data").AtName(name),
+				"Invalid Additional Data",
+				fmt.Sprintf("additional_data.%s must be one of %s, got: %s", name, strings.Join(allowed, ", "), str.ValueString()),
+			)
+		}
+	}
+}
+
+// stringValueOrNull returns a string value, or null when the string is empty.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
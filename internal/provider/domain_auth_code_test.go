@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// domainAuthCodeAPI returns the routes of a fake API serving example.com with an
+// auth code that changes on every reset.
+func domainAuthCodeAPI() fakeAPI {
+	authCode := "old-code"
+	return fakeAPI{
+		"GET /v1beta/domains": respondWith(`{"code": 0, "data": {"results": [{"id": 123, "status": "ACT", "domain": {"name": "example", "extension": "com"}}]}}`),
+		"GET /v1beta/domains/123/authcode": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"auth_code": %q, "type": "internal"}}`, authCode)
+		},
+		"POST /v1beta/domains/123/authcode/reset": func(w http.ResponseWriter, _ *http.Request) {
+			authCode = "new-code"
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"auth_code": %q, "type": "internal"}}`, authCode)
+		},
+	}
+}
+
+func TestDomainAuthCodeEphemeralResourceSchema(t *testing.T) {
+	ctx := context.Background()
+	e := NewDomainAuthCodeEphemeralResource()
+	resp := &ephemeral.SchemaResponse{}
+	e.Schema(ctx, ephemeral.SchemaRequest{}, resp)
+
+	if !resp.Schema.Attributes["domain"].IsRequired() {
+		t.Error("domain should be Required")
+	}
+	if !resp.Schema.Attributes["auth_code"].IsSensitive() {
+		t.Error("auth_code should be Sensitive")
+	}
+}
+
+func TestDomainAuthCodeResetAndRead(t *testing.T) {
+	ctx := context.Background()
+	c := newFakeAPIClient(t, domainAuthCodeAPI())
+
+	e := &DomainAuthCodeEphemeralResource{client: c}
+	schemaResp := &ephemeral.SchemaResponse{}
+	e.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"domain":    tftypes.NewValue(tftypes.String, "example.com"),
+			"auth_code": tftypes.NewValue(tftypes.String, nil),
+			"type":      tftypes.NewValue(tftypes.String, nil),
+		}),
+	}
+
+	readAuthCode := func(t *testing.T) string {
+		t.Helper()
+		resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: config.Raw}}
+		e.Open(ctx, ephemeral.OpenRequest{Config: config}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+		}
+		var authCode types.String
+		resp.Result.GetAttribute(ctx, path.Root("auth_code"), &authCode)
+		return authCode.ValueString()
+	}
+
+	if got := readAuthCode(t); got != "old-code" {
+		t.Errorf("Expected auth code old-code, got %s", got)
+	}
+
+	a := &DomainAuthCodeResetAction{client: c}
+	actionSchemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, actionSchemaResp)
+	actionType := actionSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	invokeResp := &action.InvokeResponse{}
+	a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{
+		Schema: actionSchemaResp.Schema,
+		Raw: tftypes.NewValue(actionType, map[string]tftypes.Value{
+			"domain": tftypes.NewValue(tftypes.String, "example.com"),
+		}),
+	}}, invokeResp)
+	if invokeResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", invokeResp.Diagnostics)
+	}
+
+	if got := readAuthCode(t); got != "new-code" {
+		t.Errorf("Expected auth code new-code after reset, got %s", got)
+	}
+}
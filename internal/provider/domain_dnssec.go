@@ -0,0 +1,213 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	dnslib "github.com/charpand/terraform-provider-openprovider/internal/client/dns"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dsRecordsAttrTypes defines the attribute types for DS records.
+var dsRecordsAttrTypes = map[string]attr.Type{
+	"key_tag":     types.Int64Type,
+	"algorithm":   types.Int64Type,
+	"digest_type": types.Int64Type,
+	"digest":      types.StringType,
+}
+
+// zoneSigningKeys returns the key signing keys of a zone, which are the keys
+// published at the registry. Zones without a separate key signing key publish
+// all of their keys.
+func zoneSigningKeys(zone *dnslib.Zone) []dnslib.DnssecKey {
+	var keys []dnslib.DnssecKey
+	for _, key := range zone.DnssecKeys {
+		if key.Flags == dnslib.FlagsKSK {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return zone.DnssecKeys
+	}
+	return keys
+}
+
+// zoneKeysToAPI converts zone keys to the format used for registry DNSSEC keys.
+func zoneKeysToAPI(keys []dnslib.DnssecKey) []domains.DnssecKey {
+	apiKeys := make([]domains.DnssecKey, 0, len(keys))
+	for _, key := range keys {
+		apiKeys = append(apiKeys, domains.DnssecKey{
+			Alg:      key.Alg,
+			Flags:    key.Flags,
+			Protocol: key.Protocol,
+			PubKey:   key.PubKey,
+		})
+	}
+	return apiKeys
+}
+
+// mapZoneKeysToState converts the signing keys of a zone to the dnskey_records
+// and ds_records attributes.
+func mapZoneKeysToState(ctx context.Context, zoneName string, keys []dnslib.DnssecKey, diags *diag.Diagnostics) (types.List, types.List) {
+	dnskeyRecords := mapDnssecKeysToState(ctx, zoneKeysToAPI(keys), diags)
+	if len(keys) == 0 {
+		return dnskeyRecords, types.ListNull(types.ObjectType{AttrTypes: dsRecordsAttrTypes})
+	}
+
+	records := make([]DSRecordModel, 0, len(keys))
+	for _, key := range keys {
+		ds, err := dnslib.ComputeDS(zoneName, key)
+		if err != nil {
+			diags.AddError(
+				"Invalid DNSSEC Key",
+				fmt.Sprintf("Could not calculate the DS record for a DNSKEY of zone %s: %s", zoneName, err.Error()),
+			)
+			continue
+		}
+		records = append(records, DSRecordModel{
+			KeyTag:     types.Int64Value(int64(ds.KeyTag)),
+			Algorithm:  types.Int64Value(int64(ds.Algorithm)),
+			DigestType: types.Int64Value(int64(ds.DigestType)),
+			Digest:     types.StringValue(ds.Digest),
+		})
+	}
+
+	dsRecords, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dsRecordsAttrTypes}, records)
+	diags.Append(listDiags...)
+	return dnskeyRecords, dsRecords
+}
+
+// publishedKeysMatch reports whether the DNSSEC keys published at the registry
+// match the signing keys of the zone. Keys are compared by public key.
+func publishedKeysMatch(ctx context.Context, published types.List, signing types.List) bool {
+	if published.IsUnknown() || signing.IsUnknown() {
+		return true
+	}
+
+	publicKeys := func(list types.List) map[string]bool {
+		var keys []DnssecKeyModel
+		var diags diag.Diagnostics
+		diags.Append(list.ElementsAs(ctx, &keys, false)...)
+
+		result := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			result[strings.Join(strings.Fields(key.PublicKey.ValueString()), "")] = true
+		}
+		return result
+	}
+
+	publishedKeys, signingKeys := publicKeys(published), publicKeys(signing)
+	if len(publishedKeys) != len(signingKeys) {
+		return false
+	}
+	for key := range signingKeys {
+		if !publishedKeys[key] {
+			return false
+		}
+	}
+	return true
+}
+
+// enableZoneSigning enables DNSSEC signing for the zone of a domain on OpenProvider
+// DNS, if needed, and returns the zone's signing keys for publication at the registry.
+func (r *DomainResource) enableZoneSigning(domainName string, diags *diag.Diagnostics) []domains.DnssecKey {
+	zone, err := dnslib.GetZone(r.client, domainName)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("managed_dnssec"),
+			"DNS Zone Not Available",
+			fmt.Sprintf("managed_dnssec requires the zone of domain %s to be hosted on OpenProvider DNS, but it could not be read: %s", domainName, err.Error()),
+		)
+		return nil
+	}
+
+	if !zone.IsDnssecEnabled {
+		enabled := true
+		if err := dnslib.UpdateZone(r.client, domainName, &dnslib.UpdateZoneRequest{IsDnssecEnabled: &enabled}); err != nil {
+			diags.AddAttributeError(
+				path.Root("managed_dnssec"),
+				"Error Enabling DNSSEC Signing",
+				fmt.Sprintf("Could not enable DNSSEC signing for zone %s: %s", domainName, err.Error()),
+			)
+			return nil
+		}
+
+		zone, err = dnslib.GetZone(r.client, domainName)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("managed_dnssec"),
+				"Error Reading DNSSEC Keys",
+				fmt.Sprintf("DNSSEC signing was enabled for zone %s, but its keys could not be read: %s", domainName, err.Error()),
+			)
+			return nil
+		}
+	}
+
+	keys := zoneSigningKeys(zone)
+	if len(keys) == 0 {
+		diags.AddAttributeError(
+			path.Root("managed_dnssec"),
+			"DNSSEC Keys Not Available",
+			fmt.Sprintf("DNSSEC signing is enabled for zone %s, but no keys have been generated yet. The keys are published on an apply once the zone has been signed.", domainName),
+		)
+		return nil
+	}
+
+	return zoneKeysToAPI(keys)
+}
+
+// disableZoneSigning disables DNSSEC signing for the zone of a domain. The DS
+// records must have been removed from the registry first.
+func (r *DomainResource) disableZoneSigning(domainName string, diags *diag.Diagnostics) {
+	enabled := false
+	if err := dnslib.UpdateZone(r.client, domainName, &dnslib.UpdateZoneRequest{IsDnssecEnabled: &enabled}); err != nil {
+		diags.AddAttributeError(
+			path.Root("managed_dnssec"),
+			"Error Disabling DNSSEC Signing",
+			fmt.Sprintf("The DS records of domain %s were removed from the registry, but DNSSEC signing could not be disabled for its zone: %s", domainName, err.Error()),
+		)
+	}
+}
+
+// readZoneSigningKeys refreshes dnskey_records and ds_records from the zone of a
+// domain with managed DNSSEC, and warns when the zone's keys have been rolled over
+// and no longer match the keys published at the registry.
+func (r *DomainResource) readZoneSigningKeys(ctx context.Context, state *DomainModel, diags *diag.Diagnostics) {
+	domainName := state.Domain.ValueString()
+	zone, err := dnslib.GetZone(r.client, domainName)
+	if err != nil {
+		if state.DnskeyRecords.IsUnknown() {
+			state.DnskeyRecords = types.ListNull(types.ObjectType{AttrTypes: dnssecKeysAttrTypes})
+			state.DSRecords = types.ListNull(types.ObjectType{AttrTypes: dsRecordsAttrTypes})
+		}
+		diags.AddWarning(
+			"Unable to Read DNSSEC Keys",
+			fmt.Sprintf("Could not read the zone of domain %s, so its DNSSEC keys were not refreshed: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	state.DnskeyRecords, state.DSRecords = mapZoneKeysToState(ctx, domainName, zoneSigningKeys(zone), diags)
+
+	switch {
+	case publishedKeysMatch(ctx, state.DnssecKeys, state.DnskeyRecords):
+	case len(state.DnssecKeys.Elements()) == 0:
+		diags.AddWarning(
+			"DNSSEC Keys Not Yet Published",
+			fmt.Sprintf("The signing keys of zone %s have not been published at the registry yet. "+
+				"They will be published on the next apply.", domainName),
+		)
+	default:
+		diags.AddWarning(
+			"DNSSEC Key Rollover Detected",
+			fmt.Sprintf("The signing keys of zone %s no longer match the DNSSEC keys published at the registry. "+
+				"The new keys will be published on the next apply.", domainName),
+		)
+	}
+}
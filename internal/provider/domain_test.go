@@ -2,14 +2,26 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
+	dnslib "github.com/charpand/terraform-provider-openprovider/internal/client/dns"
 	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestDomainResourceSchema(t *testing.T) {
@@ -26,7 +38,10 @@ func TestDomainResourceSchema(t *testing.T) {
 		"id", "domain", "auth_code", "status", "autorenew",
 		"owner_handle", "admin_handle", "tech_handle", "billing_handle",
 		"period", "ns_group", "dnssec_keys", "is_dnssec_enabled",
-		"expiration_date",
+		"expiration_date", "wait_for_transfer", "transfer_status",
+		"transfer_approver_email", "is_locked", "whois_privacy",
+		"whois_privacy_status", "allow_owner_change", "owner_verification_status",
+		"can_renew", "is_abusive", "managed_dnssec", "dnskey_records", "ds_records",
 	}
 	for _, attr := range expectedAttrs {
 		if _, ok := resp.Schema.Attributes[attr]; !ok {
@@ -34,6 +49,10 @@ func TestDomainResourceSchema(t *testing.T) {
 		}
 	}
 
+	if _, ok := resp.Schema.Blocks["timeouts"]; !ok {
+		t.Error("Expected timeouts block not found in schema")
+	}
+
 	// Verify auth_code is sensitive
 	authCodeAttr := resp.Schema.Attributes["auth_code"]
 	if strAttr, ok := authCodeAttr.(interface{ IsSensitive() bool }); ok {
@@ -53,7 +72,11 @@ func TestDomainDataSourceSchema(t *testing.T) {
 		t.Fatal("Schema attributes should not be nil")
 	}
 
-	expectedAttrs := []string{"id", "domain", "status", "autorenew", "owner_handle", "admin_handle", "tech_handle", "billing_handle", "period"}
+	expectedAttrs := []string{
+		"id", "domain_id", "domain", "status", "autorenew", "owner_handle", "admin_handle", "tech_handle", "billing_handle",
+		"period", "is_locked", "whois_privacy", "whois_privacy_status", "creation_date", "active_date",
+		"expiration_date", "is_abusive", "can_renew", "name_servers", "ns_group", "dnssec_keys", "is_dnssec_enabled",
+	}
 	for _, attr := range expectedAttrs {
 		if _, ok := resp.Schema.Attributes[attr]; !ok {
 			t.Errorf("Expected attribute %s not found in schema", attr)
@@ -61,6 +84,197 @@ func TestDomainDataSourceSchema(t *testing.T) {
 	}
 }
 
+// dataSourceConfig builds a data source configuration for the given attribute values.
+// Attributes that are not specified are set to null.
+func dataSourceConfig(t *testing.T, d datasource.DataSource, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	objectType, ok := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("Expected schema to have an object type")
+	}
+
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return tfsdk.Config{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(objectType, attrs),
+	}
+}
+
+func TestDomainDataSourceValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	d := &DomainDataSource{}
+
+	testCases := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{"by domain", map[string]tftypes.Value{"domain": tftypes.NewValue(tftypes.String, "example.com")}, false},
+		{"by domain_id", map[string]tftypes.Value{"domain_id": tftypes.NewValue(tftypes.Number, 123)}, false},
+		{"neither", map[string]tftypes.Value{}, true},
+		{"both", map[string]tftypes.Value{
+			"domain_id": tftypes.NewValue(tftypes.Number, 123),
+			"domain":    tftypes.NewValue(tftypes.String, "example.com"),
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: dataSourceConfig(t, d, tc.values)}, resp)
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDomainDataSourceReadByDomainID(t *testing.T) {
+	ctx := context.Background()
+	c := newFakeAPIClient(t, fakeAPI{
+		"GET /v1beta/domains/123": respondWith(`{"code": 0, "data": {
+			"id": 123,
+			"domain": {"name": "example", "extension": "com"},
+			"status": "ACT",
+			"creation_date": "2024-01-01 10:00:00",
+			"expiration_date": "2027-01-01 10:00:00",
+			"can_renew": true,
+			"name_servers": [{"name": "ns1.example.net"}, {"name": "ns1.example.com", "ip": "192.0.2.1"}],
+			"dnssec_keys": [{"alg": 13, "flags": 257, "protocol": 3, "pub_key": "AwEAAb"}],
+			"is_dnssec_enabled": true
+		}}`),
+	})
+	d := &DomainDataSource{client: c}
+	config := dataSourceConfig(t, d, map[string]tftypes.Value{"domain_id": tftypes.NewValue(tftypes.Number, 123)})
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var state DomainDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if state.Domain.ValueString() != "example.com" || state.ID.ValueString() != "example.com" || state.DomainID.ValueInt64() != 123 {
+		t.Errorf("Expected example.com with domain_id 123, got %s with id %s and domain_id %s", state.Domain, state.ID, state.DomainID)
+	}
+	if state.CreationDate.ValueString() != "2024-01-01 10:00:00" || !state.ActiveDate.IsNull() || !state.CanRenew.ValueBool() {
+		t.Errorf("Unexpected lifecycle attributes: %+v", state)
+	}
+	if len(state.Nameservers) != 2 || state.Nameservers[1].IP.ValueString() != "192.0.2.1" || !state.Nameservers[0].IP.IsNull() {
+		t.Errorf("Unexpected nameservers: %+v", state.Nameservers)
+	}
+	if len(state.DnssecKeys.Elements()) != 1 || !state.IsDnssecEnabled.ValueBool() || !state.NSGroup.IsNull() {
+		t.Errorf("Unexpected DNSSEC attributes: %v %v", state.DnssecKeys, state.IsDnssecEnabled)
+	}
+}
+
+func TestDomainsDataSourceReadFiltersByExpiry(t *testing.T) {
+	ctx := context.Background()
+	soon := time.Now().AddDate(0, 0, 10).Format("2006-01-02 15:04:05")
+	later := time.Now().AddDate(1, 0, 0).Format("2006-01-02 15:04:05")
+
+	var query url.Values
+	c := newFakeAPIClient(t, fakeAPI{
+		"GET /v1beta/domains": func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"total": 3, "results": [
+				{"id": 1, "domain": {"name": "soon", "extension": "nl"}, "status": "ACT", "expiration_date": %q},
+				{"id": 2, "domain": {"name": "later", "extension": "nl"}, "status": "ACT", "expiration_date": %q},
+				{"id": 3, "domain": {"name": "unknown", "extension": "nl"}, "status": "ACT"}
+			]}}`, soon, later)
+		},
+	})
+	d := &DomainsDataSource{client: c}
+	config := dataSourceConfig(t, d, map[string]tftypes.Value{
+		"extension":           tftypes.NewValue(tftypes.String, ".nl"),
+		"status":              tftypes.NewValue(tftypes.String, "act"),
+		"expires_within_days": tftypes.NewValue(tftypes.Number, 30),
+	})
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if query.Get("extension") != "nl" || query.Get("status") != "ACT" {
+		t.Errorf("Expected server-side filters extension=nl and status=ACT, got %v", query)
+	}
+
+	var state DomainsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if len(state.Domains) != 1 || state.Domains[0].Domain.ValueString() != "soon.nl" || state.Domains[0].DomainID.ValueInt64() != 1 {
+		t.Errorf("Expected only soon.nl within the expiry window, got %+v", state.Domains)
+	}
+}
+
+func TestDomainsDataSourceReadFiltersByOwner(t *testing.T) {
+	ctx := context.Background()
+
+	var query url.Values
+	c := newFakeAPIClient(t, fakeAPI{
+		// contact_handle matches the handle in any contact role
+		"GET /v1beta/domains": func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"total": 2, "results": [
+				{"id": 1, "domain": {"name": "owned", "extension": "nl"}, "status": "ACT", "owner_handle": "OW123", "tech_handle": "OW123"},
+				{"id": 2, "domain": {"name": "managed", "extension": "nl"}, "status": "ACT", "owner_handle": "OTHER", "tech_handle": "OW123"}
+			]}}`)
+		},
+	})
+	d := &DomainsDataSource{client: c}
+	config := dataSourceConfig(t, d, map[string]tftypes.Value{
+		"owner_handle": tftypes.NewValue(tftypes.String, "OW123"),
+	})
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	if query.Get("contact_handle") != "OW123" {
+		t.Errorf("Expected the server-side contact_handle filter, got %v", query)
+	}
+
+	var state DomainsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if len(state.Domains) != 1 || state.Domains[0].Domain.ValueString() != "owned.nl" {
+		t.Errorf("Expected only the domain owned by OW123, got %+v", state.Domains)
+	}
+}
+
+func TestDomainsDataSourceValidateConfigExpiryWindow(t *testing.T) {
+	ctx := context.Background()
+	d := &DomainsDataSource{}
+
+	config := dataSourceConfig(t, d, map[string]tftypes.Value{"expires_within_days": tftypes.NewValue(tftypes.Number, -1)})
+	resp := &datasource.ValidateConfigResponse{}
+	d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: config}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected an error for a negative expiry window")
+	}
+}
+
 func TestDomainResourceMetadata(t *testing.T) {
 	ctx := context.Background()
 	r := NewDomainResource()
@@ -123,6 +337,24 @@ func TestDomainResourceDnssecKeysHasPlanModifier(t *testing.T) {
 	}
 }
 
+func TestDomainResourceTransferAttributesUseStateForUnknown(t *testing.T) {
+	ctx := context.Background()
+	r := NewDomainResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+	// Without UseStateForUnknown every update shows these as known after apply
+	for _, name := range []string{"transfer_status", "transfer_approver_email"} {
+		attr, ok := resp.Schema.Attributes[name].(schema.StringAttribute)
+		if !ok {
+			t.Fatalf("%s attribute not found in schema", name)
+		}
+		if len(attr.PlanModifiers) == 0 {
+			t.Errorf("%s should keep its state value when planning updates", name)
+		}
+	}
+}
+
 func TestDomainResourceIsDnssecEnabledHasPlanModifier(t *testing.T) {
 	ctx := context.Background()
 	r := NewDomainResource()
@@ -154,6 +386,121 @@ func TestDomainResourceIsDnssecEnabledHasPlanModifier(t *testing.T) {
 	}
 }
 
+func TestDomainResourceIsLockedOptionalComputed(t *testing.T) {
+	ctx := context.Background()
+	r := NewDomainResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+	attr, ok := resp.Schema.Attributes["is_locked"]
+	if !ok {
+		t.Fatal("is_locked attribute not found in schema")
+	}
+
+	if !attr.IsOptional() {
+		t.Error("is_locked should be Optional")
+	}
+	if !attr.IsComputed() {
+		t.Error("is_locked should be Computed so an unset lock tracks the registry value")
+	}
+}
+
+// hasDiag reports whether diags contain a diagnostic with the given summary.
+func hasDiag(diags diag.Diagnostics, summary string) bool {
+	for _, d := range diags {
+		if d.Summary() == summary {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDomainResourceCreateLock(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name        string
+		authCode    string
+		status      string
+		expectLock  bool
+		expectWarn  bool
+		lockedAfter bool
+	}{
+		{"registration", "", "ACT", true, false, true},
+		{"pending transfer", "secret", "REQ", false, true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			locked := false
+			r := &DomainResource{client: newFakeAPIClient(t, fakeAPI{
+				"POST /v1beta/domains":          respondWith(`{"code": 0, "data": {"id": 123, "status": "ACT"}}`),
+				"POST /v1beta/domains/transfer": respondWith(`{"code": 0, "data": {"id": 123, "status": "REQ"}}`),
+				"GET /v1beta/domains/123": func(w http.ResponseWriter, _ *http.Request) {
+					_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"id": 123, "status": %q, "is_lockable": true, "is_locked": %t}}`, tc.status, locked)
+				},
+				"PUT /v1beta/domains/123": func(w http.ResponseWriter, _ *http.Request) {
+					locked = true
+					_, _ = fmt.Fprint(w, `{"code": 0, "data": {"id": 123}}`)
+				},
+				"GET /v1beta/customers/verifications/emails/domains": respondWith(`{"code": 0, "data": {"results": []}}`),
+			})}
+
+			values := map[string]tftypes.Value{
+				"domain":       tftypes.NewValue(tftypes.String, "example.com"),
+				"owner_handle": tftypes.NewValue(tftypes.String, "owner"),
+				"is_locked":    tftypes.NewValue(tftypes.Bool, true),
+			}
+			if tc.authCode != "" {
+				values["auth_code"] = tftypes.NewValue(tftypes.String, tc.authCode)
+			}
+			config := resourceConfig(t, r, values)
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+			r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+			}
+			if locked != tc.expectLock {
+				t.Errorf("Expected lock update: %v, got %v", tc.expectLock, locked)
+			}
+			if hasDiag(resp.Diagnostics.Warnings(), "Registrar Lock Not Yet Set") != tc.expectWarn {
+				t.Errorf("Expected lock warning: %v, got %v", tc.expectWarn, resp.Diagnostics)
+			}
+
+			var state DomainModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+			if state.IsLocked.ValueBool() != tc.lockedAfter {
+				t.Errorf("Expected is_locked %v in state, got %s", tc.lockedAfter, state.IsLocked)
+			}
+		})
+	}
+}
+
+func TestDomainResourceTransferOptionsRequireReplace(t *testing.T) {
+	ctx := context.Background()
+	r := NewDomainResource()
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+	for _, name := range []string{"import_nameservers_from_registry", "import_contacts_from_registry", "import_dns_zone"} {
+		attr, ok := resp.Schema.Attributes[name].(schema.BoolAttribute)
+		if !ok {
+			t.Fatalf("%s attribute not found in schema", name)
+		}
+		if len(attr.PlanModifiers) == 0 {
+			t.Errorf("%s should require replacement, as it is only used when the transfer is initiated", name)
+		}
+	}
+
+	attr, ok := resp.Schema.Attributes["transfer_nameservers"].(schema.ListNestedAttribute)
+	if !ok {
+		t.Fatal("transfer_nameservers attribute not found in schema")
+	}
+	if len(attr.PlanModifiers) == 0 {
+		t.Error("transfer_nameservers should require replacement, as it is only used when the transfer is initiated")
+	}
+}
+
 func TestMapDnssecKeysToStatePreservesValues(t *testing.T) {
 	ctx := context.Background()
 
@@ -271,3 +618,1094 @@ func TestConvertDnssecKeysToAPIHandlesNull(t *testing.T) {
 		})
 	}
 }
+
+func TestTransferStatus(t *testing.T) {
+	testCases := []struct {
+		status   string
+		current  string
+		expected string
+	}{
+		{domains.StatusRequested, "", "pending"},
+		{domains.StatusPending, "pending", "pending"},
+		{domains.StatusActive, "pending", "completed"},
+		{domains.StatusFailed, "pending", "failed"},
+		{domains.StatusRejected, "", "failed"},
+		// Later statuses of a transferred domain do not reopen the transfer
+		{domains.StatusExpired, "completed", "completed"},
+		{domains.StatusDeleted, "completed", "completed"},
+		{domains.StatusPending, "completed", "completed"},
+		{domains.StatusRestoreRequested, "completed", "completed"},
+	}
+
+	for _, tc := range testCases {
+		if got := transferStatus(tc.status, tc.current); got != tc.expected {
+			t.Errorf("Expected transfer status %q for %s after %q, got %q", tc.expected, tc.status, tc.current, got)
+		}
+	}
+}
+
+// domainStatusAPI returns the routes of a fake API that reports the given statuses for
+// consecutive GET requests on a single domain, repeating the last one.
+func domainStatusAPI(statuses []string, description string) fakeAPI {
+	calls := 0
+	return fakeAPI{
+		"GET /v1beta/domains/123": func(w http.ResponseWriter, _ *http.Request) {
+			status := statuses[min(calls, len(statuses)-1)]
+			calls++
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"id": 123, "status": %q, "status_description": %q}}`, status, description)
+		},
+	}
+}
+
+func TestWaitForTransfer(t *testing.T) {
+	originalInterval := transferPollInterval
+	transferPollInterval = time.Millisecond
+	t.Cleanup(func() { transferPollInterval = originalInterval })
+
+	t.Run("completes when the domain becomes active", func(t *testing.T) {
+		c := newFakeAPIClient(t, domainStatusAPI([]string{"REQ", "REQ", "ACT"}, ""))
+
+		domain, err := waitForTransfer(context.Background(), c, 123)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if domain.Status != domains.StatusActive {
+			t.Errorf("Expected status ACT, got %s", domain.Status)
+		}
+	})
+
+	t.Run("surfaces the registry reason on failure", func(t *testing.T) {
+		c := newFakeAPIClient(t, domainStatusAPI([]string{"REQ", "FAI"}, "invalid auth code"))
+
+		_, err := waitForTransfer(context.Background(), c, 123)
+		if err == nil {
+			t.Fatal("Expected error for failed transfer, got nil")
+		}
+		if got := err.Error(); got != "transfer ended with status FAI: invalid auth code" {
+			t.Errorf("Unexpected error message: %s", got)
+		}
+	})
+
+	t.Run("returns the last seen domain on timeout", func(t *testing.T) {
+		c := newFakeAPIClient(t, domainStatusAPI([]string{"REQ"}, ""))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		domain, err := waitForTransfer(ctx, c, 123)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Expected deadline exceeded, got %v", err)
+		}
+		if domain == nil || domain.Status != domains.StatusRequested {
+			t.Errorf("Expected last seen domain with status REQ, got %v", domain)
+		}
+	})
+}
+
+// resourceConfig builds a configuration for the schema of r with the given attribute
+// values. All other attributes and blocks are null.
+func resourceConfig(t *testing.T, r resource.Resource, values map[string]tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+	objectType, ok := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("Expected schema to have an object type")
+	}
+
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attrs[name] = value
+		} else {
+			attrs[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return tfsdk.Config{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(objectType, attrs),
+	}
+}
+
+func TestDomainResourceValidateConfigTransferOnly(t *testing.T) {
+	ctx := context.Background()
+	r := &DomainResource{}
+
+	nameserverType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+		"ip":   tftypes.String,
+		"ip6":  tftypes.String,
+	}}
+	nameservers := tftypes.NewValue(tftypes.List{ElementType: nameserverType}, []tftypes.Value{
+		tftypes.NewValue(nameserverType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "ns1.example.net"),
+			"ip":   tftypes.NewValue(tftypes.String, nil),
+			"ip6":  tftypes.NewValue(tftypes.String, nil),
+		}),
+	})
+
+	testCases := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{
+			name: "import options without auth_code",
+			values: map[string]tftypes.Value{
+				"domain":                        tftypes.NewValue(tftypes.String, "example.com"),
+				"import_contacts_from_registry": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: true,
+		},
+		{
+			name: "import options with auth_code",
+			values: map[string]tftypes.Value{
+				"domain":                           tftypes.NewValue(tftypes.String, "example.com"),
+				"auth_code":                        tftypes.NewValue(tftypes.String, "secret"),
+				"import_contacts_from_registry":    tftypes.NewValue(tftypes.Bool, true),
+				"import_nameservers_from_registry": tftypes.NewValue(tftypes.Bool, true),
+				"import_dns_zone":                  tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: false,
+		},
+		{
+			name: "unknown auth_code",
+			values: map[string]tftypes.Value{
+				"domain":          tftypes.NewValue(tftypes.String, "example.com"),
+				"auth_code":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"import_dns_zone": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: false,
+		},
+		{
+			name: "transfer nameservers with ns_group",
+			values: map[string]tftypes.Value{
+				"domain":               tftypes.NewValue(tftypes.String, "example.com"),
+				"auth_code":            tftypes.NewValue(tftypes.String, "secret"),
+				"ns_group":             tftypes.NewValue(tftypes.String, "my-group"),
+				"transfer_nameservers": nameservers,
+			},
+			expectError: true,
+		},
+		{
+			name: "registry nameservers with transfer nameservers",
+			values: map[string]tftypes.Value{
+				"domain":                           tftypes.NewValue(tftypes.String, "example.com"),
+				"auth_code":                        tftypes.NewValue(tftypes.String, "secret"),
+				"import_nameservers_from_registry": tftypes.NewValue(tftypes.Bool, true),
+				"transfer_nameservers":             nameservers,
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: resourceConfig(t, r, tc.values)}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestWhoisPrivacyStatus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		domain   domains.Domain
+		expected string
+	}{
+		{"enabled", domains.Domain{IsPrivateWhoisAllowed: true, IsPrivateWhoisEnabled: true}, "enabled"},
+		{"disabled", domains.Domain{IsPrivateWhoisAllowed: true}, "disabled"},
+		{"not allowed", domains.Domain{}, "not_allowed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := whoisPrivacyStatus(&tc.domain); got != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestDomainResourceModifyPlanWhoisPrivacy(t *testing.T) {
+	ctx := context.Background()
+	r := &DomainResource{client: newFakeAPIClient(t, fakeAPI{
+		"GET /v1beta/tlds/{name}": func(w http.ResponseWriter, req *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"name": %q, "min_period": 1, "max_period": 10, "transfer_available": true, "is_private_whois_allowed": %t}}`,
+				req.PathValue("name"), req.PathValue("name") != "us")
+		},
+	})}
+
+	testCases := []struct {
+		name        string
+		domain      string
+		enabled     bool
+		expectError bool
+	}{
+		{"allowed tld", "example.com", true, false},
+		{"prohibited tld", "example.us", true, true},
+		{"prohibited tld upper case", "EXAMPLE.US", true, true},
+		{"disabled on prohibited tld", "example.us", false, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := resourceConfig(t, r, map[string]tftypes.Value{
+				"domain":        tftypes.NewValue(tftypes.String, tc.domain),
+				"auth_code":     tftypes.NewValue(tftypes.String, "secret"),
+				"whois_privacy": tftypes.NewValue(tftypes.Bool, tc.enabled),
+			})
+			state := tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)}
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, State: state, Plan: resp.Plan}, resp)
+
+			if hasDiag(resp.Diagnostics.Errors(), "WHOIS Privacy Not Allowed") != tc.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDomainResourceCreateKeepsPlannedWhoisPrivacy(t *testing.T) {
+	ctx := context.Background()
+	r := &DomainResource{client: newFakeAPIClient(t, fakeAPI{
+		// The create response does not report the privacy setting yet
+		"POST /v1beta/domains":                               respondWith(`{"code": 0, "data": {"id": 123, "status": "ACT"}}`),
+		"GET /v1beta/customers/verifications/emails/domains": respondWith(`{"code": 0, "data": {"results": []}}`),
+	})}
+
+	config := resourceConfig(t, r, map[string]tftypes.Value{
+		"domain":        tftypes.NewValue(tftypes.String, "example.com"),
+		"owner_handle":  tftypes.NewValue(tftypes.String, "owner"),
+		"whois_privacy": tftypes.NewValue(tftypes.Bool, true),
+	})
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var state DomainModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if !state.WhoisPrivacy.ValueBool() {
+		t.Errorf("Expected the planned whois_privacy to be kept, got %s", state.WhoisPrivacy)
+	}
+	if state.PrivacyStatus.ValueString() != "enabled" {
+		t.Errorf("Expected whois_privacy_status enabled, got %s", state.PrivacyStatus)
+	}
+}
+
+func TestDomainResourceValidateConfigAdditionalData(t *testing.T) {
+	ctx := context.Background()
+	r := &DomainResource{}
+
+	additionalDataType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"trustee_service":     tftypes.Bool,
+		"nexus_category":      tftypes.String,
+		"application_purpose": tftypes.String,
+		"legal_type":          tftypes.String,
+		"entity_type":         tftypes.String,
+		"id_number":           tftypes.String,
+	}}
+	additionalData := func(values map[string]tftypes.Value) tftypes.Value {
+		attrs := make(map[string]tftypes.Value, len(additionalDataType.AttributeTypes))
+		for name, attrType := range additionalDataType.AttributeTypes {
+			if value, ok := values[name]; ok {
+				attrs[name] = value
+			} else {
+				attrs[name] = tftypes.NewValue(attrType, nil)
+			}
+		}
+		return tftypes.NewValue(additionalDataType, attrs)
+	}
+
+	testCases := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{
+			name: "no requirements",
+			values: map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, "example.com"),
+			},
+			expectError: false,
+		},
+		{
+			name: "us with nexus data",
+			values: map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, "example.us"),
+				"additional_data": additionalData(map[string]tftypes.Value{
+					"nexus_category":      tftypes.NewValue(tftypes.String, "C11"),
+					"application_purpose": tftypes.NewValue(tftypes.String, "P1"),
+				}),
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid ca legal type",
+			values: map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, "example.ca"),
+				"additional_data": additionalData(map[string]tftypes.Value{
+					"legal_type": tftypes.NewValue(tftypes.String, "XYZ"),
+				}),
+			},
+			expectError: true,
+		},
+		{
+			name: "de trustee service",
+			values: map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, "example.de"),
+				"additional_data": additionalData(map[string]tftypes.Value{
+					"trustee_service": tftypes.NewValue(tftypes.Bool, true),
+				}),
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid us nexus category",
+			values: map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, "example.us"),
+				"additional_data": additionalData(map[string]tftypes.Value{
+					"nexus_category":      tftypes.NewValue(tftypes.String, "C99"),
+					"application_purpose": tftypes.NewValue(tftypes.String, "P1"),
+				}),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: resourceConfig(t, r, tc.values)}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestMapAdditionalDataToState(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+
+	if got := mapAdditionalDataToState(ctx, &domains.AdditionalData{}, &diags); !got.IsNull() {
+		t.Errorf("Expected null object for empty additional data, got %v", got)
+	}
+
+	got := mapAdditionalDataToState(ctx, &domains.AdditionalData{NexusCategory: "C11", ApplicationPurpose: "P1"}, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	converted := convertAdditionalDataToAPI(ctx, got, &diags)
+	if converted == nil || converted.NexusCategory != "C11" || converted.ApplicationPurpose != "P1" || converted.UseDomicile != nil {
+		t.Errorf("Expected additional data to round-trip, got %+v", converted)
+	}
+	if attrs := got.Attributes(); !attrs["legal_type"].IsNull() || !attrs["trustee_service"].IsNull() {
+		t.Error("Expected unset additional data attributes to be null")
+	}
+
+	// A disabled trustee service is reported as false, not null
+	disabled := false
+	got = mapAdditionalDataToState(ctx, &domains.AdditionalData{UseDomicile: &disabled}, &diags)
+	if trustee, ok := got.Attributes()["trustee_service"].(types.Bool); !ok || trustee.IsNull() || trustee.ValueBool() {
+		t.Errorf("Expected trustee_service false, got %v", got)
+	}
+	converted = convertAdditionalDataToAPI(ctx, got, &diags)
+	if converted == nil || converted.UseDomicile == nil || *converted.UseDomicile {
+		t.Errorf("Expected an explicit false trustee service to be sent, got %+v", converted)
+	}
+}
+
+func TestDomainResourceUpdateClearsAdditionalData(t *testing.T) {
+	ctx := context.Background()
+
+	additionalData := `{"nexus_category": "C11", "application_purpose": "P1"}`
+	var sent map[string]json.RawMessage
+	r := &DomainResource{client: newFakeAPIClient(t, fakeAPI{
+		"GET /v1beta/domains": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"results": [{"id": 123, "status": "ACT", "domain": {"name": "example", "extension": "us"}, "additional_data": %s}]}}`, additionalData)
+		},
+		"PUT /v1beta/domains/123": func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			additionalData = string(sent["additional_data"])
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"id": 123}}`)
+		},
+		"GET /v1beta/customers/verifications/emails/domains": respondWith(`{"code": 0, "data": {"results": []}}`),
+	})}
+
+	config := resourceConfig(t, r, map[string]tftypes.Value{
+		"id":     tftypes.NewValue(tftypes.String, "example.us"),
+		"domain": tftypes.NewValue(tftypes.String, "example.us"),
+		"status": tftypes.NewValue(tftypes.String, "ACT"),
+	})
+	plan := tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}
+	state := tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}
+	var diags diag.Diagnostics
+	diags.Append(state.SetAttribute(ctx, path.Root("additional_data"), mapAdditionalDataToState(ctx, &domains.AdditionalData{NexusCategory: "C11", ApplicationPurpose: "P1"}, &diags))...)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+
+	var cleared domains.AdditionalData
+	if err := json.Unmarshal(sent["additional_data"], &cleared); err != nil || cleared != (domains.AdditionalData{}) ||
+		!strings.Contains(string(sent["additional_data"]), `"nexus_category":""`) {
+		t.Fatalf("Expected empty additional_data values to be sent, got %s", sent["additional_data"])
+	}
+
+	// The next refresh reports no additional data, so the plan is empty
+	readResp := &resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, readResp)
+	var model DomainModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &model)...)
+	if readResp.Diagnostics.HasError() || !model.AdditionalData.IsNull() {
+		t.Errorf("Expected additional_data to stay removed, got %v: %v", model.AdditionalData, readResp.Diagnostics)
+	}
+}
+
+func TestDomainResourceValidateConfigDeletionPolicy(t *testing.T) {
+	ctx := context.Background()
+	r := &DomainResource{}
+
+	testCases := []struct {
+		name        string
+		policy      string
+		force       bool
+		expectError bool
+	}{
+		{"error policy", "error", false, false},
+		{"abandon policy", "abandon", false, false},
+		{"delete policy", "delete", false, false},
+		{"forced delete", "delete", true, false},
+		{"unknown policy", "destroy", false, true},
+		{"force without delete policy", "abandon", true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{
+				"domain":          tftypes.NewValue(tftypes.String, "example.com"),
+				"deletion_policy": tftypes.NewValue(tftypes.String, tc.policy),
+				"force_delete":    tftypes.NewValue(tftypes.Bool, tc.force),
+			}
+			req := resource.ValidateConfigRequest{Config: resourceConfig(t, r, values)}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// domainDeleteAPI returns the routes of a fake API listing example.com with the given
+// creation date, recording whether the domain was deleted.
+func domainDeleteAPI(creationDate string, deleted *bool) fakeAPI {
+	return fakeAPI{
+		"GET /v1beta/domains": respondWith(`{"code": 0, "data": {"results": [{"id": 123, "creation_date": %q, "domain": {"name": "example", "extension": "com"}}]}}`, creationDate),
+		"DELETE /v1beta/domains/123": func(w http.ResponseWriter, _ *http.Request) {
+			*deleted = true
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"success": true}}`)
+		},
+	}
+}
+
+func TestDomainResourceDelete(t *testing.T) {
+	ctx := context.Background()
+	recent := time.Now().Add(-24 * time.Hour).UTC().Format(time.DateTime)
+	old := time.Now().Add(-30 * 24 * time.Hour).UTC().Format(time.DateTime)
+
+	testCases := []struct {
+		name          string
+		policy        string
+		force         bool
+		creationDate  string
+		expectError   bool
+		expectDeleted bool
+	}{
+		{"error policy refuses", "error", false, recent, true, false},
+		{"abandon keeps domain", "abandon", false, recent, false, false},
+		{"delete within add-grace period", "delete", false, recent, false, true},
+		{"delete outside add-grace period", "delete", false, old, true, false},
+		{"forced delete outside add-grace period", "delete", true, old, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deleted := false
+			r := &DomainResource{client: newFakeAPIClient(t, domainDeleteAPI(tc.creationDate, &deleted))}
+
+			config := resourceConfig(t, r, map[string]tftypes.Value{
+				"domain":          tftypes.NewValue(tftypes.String, "example.com"),
+				"deletion_policy": tftypes.NewValue(tftypes.String, tc.policy),
+				"force_delete":    tftypes.NewValue(tftypes.Bool, tc.force),
+			})
+			req := resource.DeleteRequest{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+			resp := &resource.DeleteResponse{}
+			r.Delete(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+			if deleted != tc.expectDeleted {
+				t.Errorf("Expected deleted: %v, got %v", tc.expectDeleted, deleted)
+			}
+		})
+	}
+}
+
+// domainRestoreAPI returns the routes of a fake API for example.com that starts in
+// the given status, moves to RRQ when a restore is requested and to ACT on the
+// next listing after that. Restore requests are counted in restores.
+func domainRestoreAPI(status string, restores *int) fakeAPI {
+	return fakeAPI{
+		"GET /v1beta/domains": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"results": [{"id": 123, "status": %q, "domain": {"name": "example", "extension": "com"}}]}}`, status)
+			if status == domains.StatusRestoreRequested {
+				status = domains.StatusActive
+			}
+		},
+		"POST /v1beta/domains/123/restore": func(w http.ResponseWriter, _ *http.Request) {
+			*restores++
+			status = domains.StatusRestoreRequested
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"status": "RRQ"}}`)
+		},
+		"GET /v1beta/domains/prices": respondWith(`{"code": 0, "data": {"price": {"reseller": {"currency": "EUR", "price": 99}}}}`),
+	}
+}
+
+func TestDomainResourceRestoreFromRedemption(t *testing.T) {
+	ctx := context.Background()
+	restores := 0
+	r := &DomainResource{client: newFakeAPIClient(t, domainRestoreAPI(domains.StatusDeleted, &restores))}
+
+	stateFor := func(t *testing.T, status string, restore bool) tfsdk.State {
+		config := resourceConfig(t, r, map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, "example.com"),
+			"domain":             tftypes.NewValue(tftypes.String, "example.com"),
+			"status":             tftypes.NewValue(tftypes.String, status),
+			"restore_if_expired": tftypes.NewValue(tftypes.Bool, restore),
+		})
+		return tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+	}
+	hasWarning := func(diags diag.Diagnostics, summary string) bool {
+		for _, d := range diags.Warnings() {
+			if d.Summary() == summary {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Read detects the redemption status and warns while restore is not enabled
+	readResp := &resource.ReadResponse{State: stateFor(t, domains.StatusActive, false)}
+	r.Read(ctx, resource.ReadRequest{State: readResp.State}, readResp)
+	if !hasWarning(readResp.Diagnostics, "Domain In Redemption") {
+		t.Errorf("Expected redemption warning, got %v", readResp.Diagnostics)
+	}
+
+	// With restore_if_expired the plan changes status and surfaces the fee
+	state := stateFor(t, domains.StatusDeleted, false)
+	plannedState := stateFor(t, domains.StatusDeleted, true)
+	planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: plannedState.Schema, Raw: plannedState.Raw}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: planResp.Plan}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected plan errors: %v", planResp.Diagnostics)
+	}
+	var plannedStatus types.String
+	planResp.Plan.GetAttribute(ctx, path.Root("status"), &plannedStatus)
+	if !plannedStatus.IsUnknown() {
+		t.Errorf("Expected status to be unknown in the plan, got %v", plannedStatus)
+	}
+	if !hasWarning(planResp.Diagnostics, "Domain Restore Planned") {
+		t.Errorf("Expected restore fee warning, got %v", planResp.Diagnostics)
+	}
+
+	// Apply restores the domain
+	updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: planResp.Plan.Schema, Raw: planResp.Plan.Raw}}
+	r.Update(ctx, resource.UpdateRequest{State: state, Plan: planResp.Plan}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected update errors: %v", updateResp.Diagnostics)
+	}
+	if restores != 1 {
+		t.Errorf("Expected one restore request, got %d", restores)
+	}
+	var status types.String
+	updateResp.State.GetAttribute(ctx, path.Root("status"), &status)
+	if status.ValueString() != domains.StatusRestoreRequested {
+		t.Errorf("Expected status RRQ after restore, got %s", status.ValueString())
+	}
+
+	// The next refresh sees the domain active again
+	readResp = &resource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, resource.ReadRequest{State: updateResp.State}, readResp)
+	readResp.State.GetAttribute(ctx, path.Root("status"), &status)
+	if status.ValueString() != domains.StatusActive {
+		t.Errorf("Expected status ACT after restore completed, got %s", status.ValueString())
+	}
+}
+
+// domainOwnerChangeAPI returns the routes of a fake API serving a domain owned by
+// "old-owner". Registrant changes are applied immediately, trades stay pending.
+func domainOwnerChangeAPI(extension string, tradeRequired bool, calls *[]string) fakeAPI {
+	owner := "old-owner"
+	return fakeAPI{
+		"GET /v1beta/domains": func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"results": [{"id": 123, "status": "ACT", "owner_handle": %q, "domain": {"name": "example", "extension": %q}}]}}`, owner, extension)
+		},
+		"GET /v1beta/tlds/" + extension: respondWith(`{"code": 0, "data": {"name": %q, "status": "ACT", "is_trade_required": %t}}`, extension, tradeRequired),
+		"GET /v1beta/domains/prices":    respondWith(`{"code": 0, "data": {"price": {"reseller": {"currency": "EUR", "price": 25}}}}`),
+		"POST /v1beta/domains/trade": func(w http.ResponseWriter, _ *http.Request) {
+			*calls = append(*calls, "trade")
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"id": 123, "status": "REQ"}}`)
+		},
+		"PUT /v1beta/domains/123": func(w http.ResponseWriter, r *http.Request) {
+			var req domains.UpdateDomainRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			*calls = append(*calls, "update:"+req.OwnerHandle)
+			if req.OwnerHandle != "" {
+				owner = req.OwnerHandle
+			}
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"id": 123}}`)
+		},
+	}
+}
+
+func TestDomainResourceOwnerChange(t *testing.T) {
+	ctx := context.Background()
+
+	stateFor := func(t *testing.T, r *DomainResource, domainName, owner string, allow bool) tfsdk.State {
+		config := resourceConfig(t, r, map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, domainName),
+			"domain":             tftypes.NewValue(tftypes.String, domainName),
+			"status":             tftypes.NewValue(tftypes.String, "ACT"),
+			"owner_handle":       tftypes.NewValue(tftypes.String, owner),
+			"allow_owner_change": tftypes.NewValue(tftypes.Bool, allow),
+		})
+		return tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+	}
+	t.Run("rejected without opt-in", func(t *testing.T) {
+		r := &DomainResource{}
+		state := stateFor(t, r, "example.com", "old-owner", false)
+		planned := stateFor(t, r, "example.com", "new-owner", false)
+		planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: planResp.Plan}, planResp)
+		if !hasDiag(planResp.Diagnostics.Errors(), "Owner Change Not Allowed") {
+			t.Errorf("Expected owner change to be rejected, got %v", planResp.Diagnostics)
+		}
+	})
+
+	t.Run("registrant change", func(t *testing.T) {
+		var calls []string
+		r := &DomainResource{client: newFakeAPIClient(t, domainOwnerChangeAPI("com", false, &calls))}
+		state := stateFor(t, r, "example.com", "old-owner", true)
+		planned := stateFor(t, r, "example.com", "new-owner", true)
+
+		planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: planResp.Plan}, planResp)
+		if planResp.Diagnostics.HasError() || !hasDiag(planResp.Diagnostics.Warnings(), "Owner Change Planned") {
+			t.Fatalf("Expected owner change warning, got %v", planResp.Diagnostics)
+		}
+
+		updateResp := &resource.UpdateResponse{State: planned}
+		r.Update(ctx, resource.UpdateRequest{State: state, Plan: planResp.Plan}, updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected update errors: %v", updateResp.Diagnostics)
+		}
+		if len(calls) != 1 || calls[0] != "update:new-owner" {
+			t.Errorf("Expected a single update with the new owner, got %v", calls)
+		}
+		if hasDiag(updateResp.Diagnostics.Warnings(), "Owner Change Pending Approval") {
+			t.Errorf("Did not expect a pending approval warning, got %v", updateResp.Diagnostics)
+		}
+	})
+
+	t.Run("trade", func(t *testing.T) {
+		var calls []string
+		r := &DomainResource{client: newFakeAPIClient(t, domainOwnerChangeAPI("eu", true, &calls))}
+		state := stateFor(t, r, "example.eu", "old-owner", true)
+		planned := stateFor(t, r, "example.eu", "new-owner", true)
+
+		planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: planned.Schema, Raw: planned.Raw}}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: planResp.Plan}, planResp)
+		if planResp.Diagnostics.HasError() || !hasDiag(planResp.Diagnostics.Warnings(), "Domain Trade Planned") {
+			t.Fatalf("Expected trade fee warning, got %v", planResp.Diagnostics)
+		}
+
+		updateResp := &resource.UpdateResponse{State: planned}
+		r.Update(ctx, resource.UpdateRequest{State: state, Plan: planResp.Plan}, updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected update errors: %v", updateResp.Diagnostics)
+		}
+		if len(calls) != 1 || calls[0] != "trade" {
+			t.Errorf("Expected the owner change to be submitted as a single trade, got %v", calls)
+		}
+		if !hasDiag(updateResp.Diagnostics.Warnings(), "Owner Change Pending Approval") {
+			t.Errorf("Expected pending approval warning, got %v", updateResp.Diagnostics)
+		}
+		var owner types.String
+		updateResp.State.GetAttribute(ctx, path.Root("owner_handle"), &owner)
+		if owner.ValueString() != "new-owner" {
+			t.Errorf("Expected planned owner to be kept in state, got %s", owner.ValueString())
+		}
+	})
+}
+
+func TestCheckDomainGuardrails(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	guardrails := DomainGuardrails{ExpiryWarningDays: 30, FailOnExpiryWithin: 7}
+
+	stateWith := func(expirationDate, status string, canRenew, isAbusive bool) DomainModel {
+		return DomainModel{
+			Domain:         types.StringValue("example.com"),
+			Status:         types.StringValue(status),
+			ExpirationDate: types.StringValue(expirationDate),
+			Autorenew:      types.BoolValue(false),
+			CanRenew:       types.BoolValue(canRenew),
+			IsAbusive:      types.BoolValue(isAbusive),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		state          DomainModel
+		autorenew      bool
+		restorePlanned bool
+		guardrails     DomainGuardrails
+		wantErrors     []string
+		wantWarnings   []string
+	}{
+		{name: "healthy", state: stateWith("2027-06-01 00:00:00", "ACT", true, false), guardrails: guardrails},
+		{name: "expiring within warning window", state: stateWith("2026-06-20 00:00:00", "ACT", true, false), guardrails: guardrails, wantWarnings: []string{"Domain Expiring"}},
+		{name: "expiring within failure window", state: stateWith("2026-06-05 00:00:00", "ACT", true, false), guardrails: guardrails, wantErrors: []string{"Domain Expiring"}},
+		{name: "expiring within failure window with autorenew", state: stateWith("2026-06-05 00:00:00", "ACT", true, false), autorenew: true, guardrails: guardrails, wantWarnings: []string{"Domain Expiring"}},
+		{name: "failure window disabled", state: stateWith("2026-06-05 00:00:00", "ACT", true, false), guardrails: DomainGuardrails{ExpiryWarningDays: 30}, wantWarnings: []string{"Domain Expiring"}},
+		{name: "all checks disabled", state: stateWith("2026-06-05 00:00:00", "ACT", true, false)},
+		{name: "cannot renew", state: stateWith("2027-06-01 00:00:00", "ACT", false, false), guardrails: guardrails, wantWarnings: []string{"Domain Cannot Be Renewed"}},
+		{name: "abusive", state: stateWith("2027-06-01 00:00:00", "ACT", true, true), guardrails: guardrails, wantWarnings: []string{"Domain Flagged as Abusive"}},
+		{name: "not active", state: stateWith("2027-06-01 00:00:00", "FAI", true, false), guardrails: guardrails, wantWarnings: []string{"Domain Not Active"}},
+		{name: "restore planned", state: stateWith("2026-05-01 00:00:00", domains.StatusDeleted, true, false), restorePlanned: true, guardrails: guardrails},
+		{name: "unknown renewability", state: DomainModel{Domain: types.StringValue("example.com"), CanRenew: types.BoolNull()}, guardrails: guardrails},
+	}
+
+	summaries := func(diags diag.Diagnostics) []string {
+		var result []string
+		for _, d := range diags {
+			result = append(result, d.Summary())
+		}
+		return result
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkDomainGuardrails(tc.state, tc.autorenew, tc.restorePlanned, tc.guardrails, now, &diags)
+
+			if got := summaries(diags.Errors()); fmt.Sprint(got) != fmt.Sprint(tc.wantErrors) {
+				t.Errorf("Expected errors %v, got %v", tc.wantErrors, got)
+			}
+			if got := summaries(diags.Warnings()); fmt.Sprint(got) != fmt.Sprint(tc.wantWarnings) {
+				t.Errorf("Expected warnings %v, got %v", tc.wantWarnings, got)
+			}
+		})
+	}
+}
+
+func TestDomainResourceModifyPlanFailsOnExpiry(t *testing.T) {
+	ctx := context.Background()
+	r := &DomainResource{guardrails: DomainGuardrails{ExpiryWarningDays: 30, FailOnExpiryWithin: 14}}
+
+	config := resourceConfig(t, r, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "example.com"),
+		"domain":          tftypes.NewValue(tftypes.String, "example.com"),
+		"status":          tftypes.NewValue(tftypes.String, "ACT"),
+		"expiration_date": tftypes.NewValue(tftypes.String, time.Now().AddDate(0, 0, 3).Format(time.DateTime)),
+	})
+	state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: resp.Plan}, resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatalf("Expected the plan to fail for a domain expiring in 3 days, got %v", resp.Diagnostics)
+	}
+}
+
+func TestDomainResourceModifyPlanReplacement(t *testing.T) {
+	ctx := context.Background()
+	r := &DomainResource{guardrails: DomainGuardrails{ExpiryWarningDays: 30, FailOnExpiryWithin: 14}}
+
+	stateConfig := resourceConfig(t, r, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "example.com"),
+		"domain":          tftypes.NewValue(tftypes.String, "example.com"),
+		"owner_handle":    tftypes.NewValue(tftypes.String, "old-owner"),
+		"status":          tftypes.NewValue(tftypes.String, "ACT"),
+		"expiration_date": tftypes.NewValue(tftypes.String, time.Now().AddDate(0, 0, 3).Format(time.DateTime)),
+	})
+	planConfig := resourceConfig(t, r, map[string]tftypes.Value{
+		"domain":       tftypes.NewValue(tftypes.String, "example.net"),
+		"owner_handle": tftypes.NewValue(tftypes.String, "new-owner"),
+	})
+	state := tfsdk.State{Schema: stateConfig.Schema, Raw: stateConfig.Raw}
+	plan := tfsdk.Plan{Schema: planConfig.Schema, Raw: planConfig.Raw}
+
+	// Changing domain replaces the resource, which registers a new domain
+	resp := &resource.ModifyPlanResponse{Plan: plan, RequiresReplace: path.Paths{path.Root("domain")}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: planConfig, State: state, Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no owner change or expiry errors for a replacement, got %v", resp.Diagnostics)
+	}
+}
+
+// domainDnssecAPI returns the routes of a fake API serving domain example.com and its
+// zone on OpenProvider DNS. The returned function rolls over the zone's KSK.
+func domainDnssecAPI(calls *[]string) (fakeAPI, func()) {
+	signed := false
+	ksk := "AwEAAQ=="
+	var registryKeys []domains.DnssecKey
+	api := fakeAPI{
+		"GET /v1beta/domains": func(w http.ResponseWriter, _ *http.Request) {
+			keys, _ := json.Marshal(registryKeys)
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"results": [{"id": 123, "status": "ACT", "domain": {"name": "example", "extension": "com"}, "dnssec_keys": %s, "is_dnssec_enabled": %t}]}}`, keys, len(registryKeys) > 0)
+		},
+		"GET /v1beta/tlds/com": respondWith(`{"code": 0, "data": {"name": "com", "status": "ACT", "dnssec_allowed": true}}`),
+		"GET /v1beta/dns/zones/example.com": func(w http.ResponseWriter, _ *http.Request) {
+			keys := "[]"
+			if signed {
+				keys = fmt.Sprintf(`[{"alg": 13, "flags": 257, "protocol": 3, "pub_key": %q}, {"alg": 13, "flags": 256, "protocol": 3, "pub_key": "AwEAAw=="}]`, ksk)
+			}
+			_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"name": "example", "extension": "com", "is_dnssec_enabled": %t, "dnssec_keys": %s}}`, signed, keys)
+		},
+		"PUT /v1beta/dns/zones/example.com": func(w http.ResponseWriter, r *http.Request) {
+			var req dnslib.UpdateZoneRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			signed = *req.IsDnssecEnabled
+			*calls = append(*calls, fmt.Sprintf("zone:%t", signed))
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"success": true}}`)
+		},
+		"PUT /v1beta/domains/123": func(w http.ResponseWriter, r *http.Request) {
+			var req domains.UpdateDomainRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req.DnssecKeys != nil {
+				registryKeys = req.DnssecKeys
+			}
+			if req.IsDnssecEnabled != nil && !*req.IsDnssecEnabled {
+				registryKeys = nil
+			}
+			*calls = append(*calls, fmt.Sprintf("domain:%d", len(req.DnssecKeys)))
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"id": 123}}`)
+		},
+	}
+
+	rollover := func() { ksk = "AwEAAg==" }
+	return api, rollover
+}
+
+func TestDomainResourceManagedDnssec(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	api, rollover := domainDnssecAPI(&calls)
+	r := &DomainResource{client: newFakeAPIClient(t, api)}
+
+	configFor := func(t *testing.T, managed bool) tfsdk.Config {
+		return resourceConfig(t, r, map[string]tftypes.Value{
+			"id":             tftypes.NewValue(tftypes.String, "example.com"),
+			"domain":         tftypes.NewValue(tftypes.String, "example.com"),
+			"status":         tftypes.NewValue(tftypes.String, "ACT"),
+			"managed_dnssec": tftypes.NewValue(tftypes.Bool, managed),
+		})
+	}
+	apply := func(t *testing.T, state tfsdk.State, managed bool) tfsdk.State {
+		t.Helper()
+
+		config := configFor(t, managed)
+		planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}}
+		planResp.Diagnostics.Append(planResp.Plan.SetAttribute(ctx, path.Root("managed_dnssec"), managed)...)
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, State: state, Plan: planResp.Plan}, planResp)
+		if planResp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected plan errors: %v", planResp.Diagnostics)
+		}
+
+		updateResp := &resource.UpdateResponse{State: tfsdk.State{Schema: planResp.Plan.Schema, Raw: planResp.Plan.Raw}}
+		r.Update(ctx, resource.UpdateRequest{State: state, Plan: planResp.Plan}, updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected update errors: %v", updateResp.Diagnostics)
+		}
+		return updateResp.State
+	}
+	hasWarning := func(diags diag.Diagnostics, summary string) bool {
+		for _, d := range diags.Warnings() {
+			if d.Summary() == summary {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Enabling signs the zone and publishes the KSK only
+	initial := configFor(t, false)
+	state := apply(t, tfsdk.State{Schema: initial.Schema, Raw: initial.Raw}, true)
+	if fmt.Sprint(calls) != "[zone:true domain:1]" {
+		t.Errorf("Expected the zone to be signed before publishing one key, got %v", calls)
+	}
+	var model DomainModel
+	state.Get(ctx, &model)
+	var dsRecords []DSRecordModel
+	model.DSRecords.ElementsAs(ctx, &dsRecords, false)
+	if len(dsRecords) != 1 || dsRecords[0].DigestType.ValueInt64() != 2 || dsRecords[0].Digest.ValueString() == "" {
+		t.Errorf("Expected one SHA-256 DS record, got %v", model.DSRecords)
+	}
+	if !model.IsDnssecEnabled.ValueBool() || len(model.DnssecKeys.Elements()) != 1 {
+		t.Errorf("Expected the KSK to be published, got %v", model.DnssecKeys)
+	}
+
+	// A rollover is detected on refresh and republished on the next apply
+	rollover()
+	readResp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if !hasWarning(readResp.Diagnostics, "DNSSEC Key Rollover Detected") {
+		t.Errorf("Expected a rollover warning, got %v", readResp.Diagnostics)
+	}
+	calls = nil
+	state = apply(t, readResp.State, true)
+	if fmt.Sprint(calls) != "[domain:1]" {
+		t.Errorf("Expected the new key to be published, got %v", calls)
+	}
+	readResp = &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, readResp)
+	if hasWarning(readResp.Diagnostics, "DNSSEC Key Rollover Detected") {
+		t.Errorf("Did not expect a rollover warning after publishing, got %v", readResp.Diagnostics)
+	}
+
+	// Disabling removes the keys from the registry before unsigning the zone
+	calls = nil
+	state = apply(t, readResp.State, false)
+	if fmt.Sprint(calls) != "[domain:0 zone:false]" {
+		t.Errorf("Expected the keys to be removed before unsigning the zone, got %v", calls)
+	}
+	state.Get(ctx, &model)
+	if !model.DnskeyRecords.IsNull() || !model.DSRecords.IsNull() || model.IsDnssecEnabled.ValueBool() {
+		t.Errorf("Expected DNSSEC to be disabled, got %v %v %v", model.DnskeyRecords, model.DSRecords, model.IsDnssecEnabled)
+	}
+}
+
+func TestDomainResourceCreateManagedDnssecKeysPending(t *testing.T) {
+	ctx := context.Background()
+	var calls []string
+	api, _ := domainDnssecAPI(&calls)
+	keysGenerated := false
+	api["POST /v1beta/domains"] = respondWith(`{"code": 0, "data": {"id": 123, "status": "ACT"}}`)
+	api["GET /v1beta/customers/verifications/emails/domains"] = respondWith(`{"code": 0, "data": {"results": []}}`)
+	// The zone is signed, but its keys are only generated after the domain was created
+	api["GET /v1beta/dns/zones/example.com"] = func(w http.ResponseWriter, _ *http.Request) {
+		keys := "[]"
+		if keysGenerated {
+			keys = `[{"alg": 13, "flags": 257, "protocol": 3, "pub_key": "AwEAAQ=="}]`
+		}
+		_, _ = fmt.Fprintf(w, `{"code": 0, "data": {"name": "example", "extension": "com", "is_dnssec_enabled": true, "dnssec_keys": %s}}`, keys)
+	}
+	r := &DomainResource{client: newFakeAPIClient(t, api)}
+
+	config := resourceConfig(t, r, map[string]tftypes.Value{
+		"domain":         tftypes.NewValue(tftypes.String, "example.com"),
+		"owner_handle":   tftypes.NewValue(tftypes.String, "owner"),
+		"managed_dnssec": tftypes.NewValue(tftypes.Bool, true),
+	})
+	createResp := &resource.CreateResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Expected the registered domain to be kept in state, got %v", createResp.Diagnostics)
+	}
+	if !hasDiag(createResp.Diagnostics, "DNSSEC Keys Not Available") {
+		t.Errorf("Expected a warning about the missing keys, got %v", createResp.Diagnostics)
+	}
+	var model DomainModel
+	createResp.State.Get(ctx, &model)
+	if !model.DnskeyRecords.IsNull() || !model.DSRecords.IsNull() {
+		t.Errorf("Expected no DNSSEC records before the keys are published, got %v %v", model.DnskeyRecords, model.DSRecords)
+	}
+
+	// The next refresh reads the generated keys and the next apply publishes them
+	keysGenerated = true
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() || !hasDiag(readResp.Diagnostics, "DNSSEC Keys Not Yet Published") {
+		t.Fatalf("Expected a warning about the unpublished keys, got %v", readResp.Diagnostics)
+	}
+
+	planResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: config.Schema, Raw: readResp.State.Raw}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, State: readResp.State, Plan: planResp.Plan}, planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected plan errors: %v", planResp.Diagnostics)
+	}
+	updateResp := &resource.UpdateResponse{State: readResp.State}
+	r.Update(ctx, resource.UpdateRequest{State: readResp.State, Plan: planResp.Plan}, updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected update errors: %v", updateResp.Diagnostics)
+	}
+	if fmt.Sprint(calls) != "[domain:1]" {
+		t.Errorf("Expected the generated key to be published, got %v", calls)
+	}
+}
+
+func TestDomainResourceValidateConfigManagedDnssec(t *testing.T) {
+	ctx := context.Background()
+	r := &DomainResource{}
+
+	config := resourceConfig(t, r, map[string]tftypes.Value{
+		"domain":         tftypes.NewValue(tftypes.String, "example.com"),
+		"managed_dnssec": tftypes.NewValue(tftypes.Bool, true),
+		"dnssec_keys": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"algorithm": tftypes.Number, "flags": tftypes.Number, "protocol": tftypes.Number, "public_key": tftypes.String,
+		}}}, []tftypes.Value{}),
+	})
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected dnssec_keys to conflict with managed_dnssec")
+	}
+}
+
+func TestSplitDomainName(t *testing.T) {
+	testCases := []struct {
+		domain    string
+		name      string
+		extension string
+		ok        bool
+	}{
+		{"example.com", "example", "com", true},
+		{"example.co.uk", "example", "co.uk", true},
+		{"example", "example", "", false},
+		{".com", "", "com", false},
+	}
+
+	for _, tc := range testCases {
+		name, extension, ok := splitDomainName(tc.domain)
+		if name != tc.name || extension != tc.extension || ok != tc.ok {
+			t.Errorf("Expected (%q, %q, %v) for %s, got (%q, %q, %v)", tc.name, tc.extension, tc.ok, tc.domain, name, extension, ok)
+		}
+	}
+
+	if got := domainExtension("Example.CO.UK"); got != "co.uk" {
+		t.Errorf("Expected extension co.uk, got %s", got)
+	}
+}
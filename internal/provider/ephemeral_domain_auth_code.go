@@ -0,0 +1,123 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &DomainAuthCodeEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &DomainAuthCodeEphemeralResource{}
+)
+
+// DomainAuthCodeEphemeralResource is the ephemeral resource implementation.
+type DomainAuthCodeEphemeralResource struct {
+	client *client.Client
+}
+
+// DomainAuthCodeModel describes the ephemeral resource data model.
+type DomainAuthCodeModel struct {
+	Domain   types.String `tfsdk:"domain"`
+	AuthCode types.String `tfsdk:"auth_code"`
+	Type     types.String `tfsdk:"type"`
+}
+
+// NewDomainAuthCodeEphemeralResource returns a new instance of the domain auth code ephemeral resource.
+func NewDomainAuthCodeEphemeralResource() ephemeral.EphemeralResource {
+	return &DomainAuthCodeEphemeralResource{}
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *DomainAuthCodeEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_auth_code"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *DomainAuthCodeEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the current authorization (EPP) code of a domain, needed to transfer it to another registrar. The code is never stored in Terraform state or plan files.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to retrieve the auth code for (e.g., example.com).",
+				Required:            true,
+			},
+			"auth_code": schema.StringAttribute{
+				MarkdownDescription: "The current authorization code of the domain.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of auth code reported by the registry (e.g., `internal` or `external`).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *DomainAuthCodeEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Open retrieves the auth code of the domain.
+func (e *DomainAuthCodeEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data DomainAuthCodeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := data.Domain.ValueString()
+
+	domain, err := getDomainByName(e.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Finding Domain",
+			fmt.Sprintf("Could not find domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	if domain == nil {
+		resp.Diagnostics.AddError(
+			"Domain Not Found",
+			fmt.Sprintf("Domain %s not found", domainName),
+		)
+		return
+	}
+
+	authCode, err := domains.GetAuthCode(e.client, domain.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Auth Code",
+			fmt.Sprintf("Could not read the auth code of domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	data.AuthCode = types.StringValue(authCode.AuthCode)
+	data.Type = stringValueOrNull(authCode.Type)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
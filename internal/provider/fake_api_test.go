@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client"
+)
+
+// fakeAPI maps request patterns to the handlers of a fake OpenProvider API. The
+// patterns use the http.ServeMux syntax, e.g. "GET /v1beta/domains/{id}". Requests
+// that match no pattern get a 404 response.
+type fakeAPI map[string]http.HandlerFunc
+
+// newFakeAPIClient starts a server for the routes of api and returns a client for it.
+// The server is closed when the test ends.
+func newFakeAPIClient(t *testing.T, api fakeAPI) *client.Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	for pattern, handler := range api {
+		mux.HandleFunc(pattern, handler)
+	}
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return client.NewClient(client.Config{BaseURL: server.URL, Token: "test"})
+}
+
+// writeJSON writes v as the JSON response body.
+func writeJSON(w http.ResponseWriter, v any) {
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// respondWith returns a handler that writes the formatted response body.
+func respondWith(format string, args ...any) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprintf(w, format, args...)
+	}
+}
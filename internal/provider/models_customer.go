@@ -32,6 +32,14 @@ type PhoneModel struct {
 	Number      types.String `tfsdk:"number"`
 }
 
+// CustomerAdditionalDataModel represents registry-specific customer data in Terraform state.
+type CustomerAdditionalDataModel struct {
+	BirthDate                 types.String `tfsdk:"birth_date"`
+	CompanyRegistrationNumber types.String `tfsdk:"company_registration_number"`
+	PassportNumber            types.String `tfsdk:"passport_number"`
+	SocialSecurityNumber      types.String `tfsdk:"social_security_number"`
+}
+
 // CustomerModel represents the Terraform state model for a customer.
 type CustomerModel struct {
 	ID          types.String  `tfsdk:"id"`
@@ -43,6 +51,8 @@ type CustomerModel struct {
 	Phone       *PhoneModel   `tfsdk:"phone"`
 	Address     *AddressModel `tfsdk:"address"`
 	Name        *NameModel    `tfsdk:"name"`
+
+	AdditionalData *CustomerAdditionalDataModel `tfsdk:"additional_data"`
 }
 
 // mapCustomerToModel converts a customer API response to a CustomerModel.
@@ -125,5 +135,29 @@ func mapCustomerToModel(customer *customers.Customer) *CustomerModel {
 		model.Name.Prefix = types.StringNull()
 	}
 
+	// Map additional data
+	if customer.AdditionalData != nil && *customer.AdditionalData != (customers.AdditionalData{}) {
+		model.AdditionalData = &CustomerAdditionalDataModel{
+			BirthDate:                 stringValueOrNull(customer.AdditionalData.BirthDate),
+			CompanyRegistrationNumber: stringValueOrNull(customer.AdditionalData.CompanyRegistrationNumber),
+			PassportNumber:            stringValueOrNull(customer.AdditionalData.PassportNumber),
+			SocialSecurityNumber:      stringValueOrNull(customer.AdditionalData.SocialSecurityNumber),
+		}
+	}
+
 	return model
 }
+
+// convertCustomerAdditionalDataToAPI converts Terraform customer additional data to the API format.
+func convertCustomerAdditionalDataToAPI(data *CustomerAdditionalDataModel) *customers.AdditionalData {
+	if data == nil {
+		return nil
+	}
+
+	return &customers.AdditionalData{
+		BirthDate:                 data.BirthDate.ValueString(),
+		CompanyRegistrationNumber: data.CompanyRegistrationNumber.ValueString(),
+		PassportNumber:            data.PassportNumber.ValueString(),
+		SocialSecurityNumber:      data.SocialSecurityNumber.ValueString(),
+	}
+}
@@ -2,26 +2,95 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // DomainModel represents the Terraform state model for a domain.
 // This is separate from the API model and uses Terraform framework types.
 type DomainModel struct {
-	ID              types.String `tfsdk:"id"`
-	Domain          types.String `tfsdk:"domain"`
-	AuthCode        types.String `tfsdk:"auth_code"`
-	Status          types.String `tfsdk:"status"`
-	Autorenew       types.Bool   `tfsdk:"autorenew"`
-	OwnerHandle     types.String `tfsdk:"owner_handle"`
-	AdminHandle     types.String `tfsdk:"admin_handle"`
-	TechHandle      types.String `tfsdk:"tech_handle"`
-	BillingHandle   types.String `tfsdk:"billing_handle"`
-	Period          types.Int64  `tfsdk:"period"`
-	NSGroup         types.String `tfsdk:"ns_group"`
-	DnssecKeys      types.List   `tfsdk:"dnssec_keys"`
-	IsDnssecEnabled types.Bool   `tfsdk:"is_dnssec_enabled"`
-	ExpirationDate  types.String `tfsdk:"expiration_date"`
+	ID                      types.String   `tfsdk:"id"`
+	Domain                  types.String   `tfsdk:"domain"`
+	AuthCode                types.String   `tfsdk:"auth_code"`
+	Status                  types.String   `tfsdk:"status"`
+	Autorenew               types.Bool     `tfsdk:"autorenew"`
+	OwnerHandle             types.String   `tfsdk:"owner_handle"`
+	AdminHandle             types.String   `tfsdk:"admin_handle"`
+	TechHandle              types.String   `tfsdk:"tech_handle"`
+	BillingHandle           types.String   `tfsdk:"billing_handle"`
+	Period                  types.Int64    `tfsdk:"period"`
+	NSGroup                 types.String   `tfsdk:"ns_group"`
+	DnssecKeys              types.List     `tfsdk:"dnssec_keys"`
+	IsDnssecEnabled         types.Bool     `tfsdk:"is_dnssec_enabled"`
+	ManagedDnssec           types.Bool     `tfsdk:"managed_dnssec"`
+	DnskeyRecords           types.List     `tfsdk:"dnskey_records"`
+	DSRecords               types.List     `tfsdk:"ds_records"`
+	IsLocked                types.Bool     `tfsdk:"is_locked"`
+	WhoisPrivacy            types.Bool     `tfsdk:"whois_privacy"`
+	PrivacyStatus           types.String   `tfsdk:"whois_privacy_status"`
+	OwnerVerificationStatus types.String   `tfsdk:"owner_verification_status"`
+	ExpirationDate          types.String   `tfsdk:"expiration_date"`
+	CanRenew                types.Bool     `tfsdk:"can_renew"`
+	IsAbusive               types.Bool     `tfsdk:"is_abusive"`
+	WaitForTransfer         types.Bool     `tfsdk:"wait_for_transfer"`
+	TransferStatus          types.String   `tfsdk:"transfer_status"`
+	ApproverEmail           types.String   `tfsdk:"transfer_approver_email"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+
+	ImportNameserversFromRegistry types.Bool `tfsdk:"import_nameservers_from_registry"`
+	ImportContactsFromRegistry    types.Bool `tfsdk:"import_contacts_from_registry"`
+	ImportDNSZone                 types.Bool `tfsdk:"import_dns_zone"`
+	TransferNameservers           types.List `tfsdk:"transfer_nameservers"`
+
+	AdditionalData types.Object `tfsdk:"additional_data"`
+	DeletionPolicy types.String `tfsdk:"deletion_policy"`
+	ForceDelete    types.Bool   `tfsdk:"force_delete"`
+
+	RestoreIfExpired types.Bool `tfsdk:"restore_if_expired"`
+	AllowOwnerChange types.Bool `tfsdk:"allow_owner_change"`
+}
+
+// DomainAdditionalDataModel represents registry-specific domain data in Terraform state.
+type DomainAdditionalDataModel struct {
+	TrusteeService     types.Bool   `tfsdk:"trustee_service"`
+	NexusCategory      types.String `tfsdk:"nexus_category"`
+	ApplicationPurpose types.String `tfsdk:"application_purpose"`
+	LegalType          types.String `tfsdk:"legal_type"`
+	EntityType         types.String `tfsdk:"entity_type"`
+	IDNumber           types.String `tfsdk:"id_number"`
+}
+
+// DomainDataSourceModel represents the Terraform state model for the domain data source.
+type DomainDataSourceModel struct {
+	ID              types.String            `tfsdk:"id"`
+	DomainID        types.Int64             `tfsdk:"domain_id"`
+	Domain          types.String            `tfsdk:"domain"`
+	Status          types.String            `tfsdk:"status"`
+	Autorenew       types.Bool              `tfsdk:"autorenew"`
+	OwnerHandle     types.String            `tfsdk:"owner_handle"`
+	AdminHandle     types.String            `tfsdk:"admin_handle"`
+	TechHandle      types.String            `tfsdk:"tech_handle"`
+	BillingHandle   types.String            `tfsdk:"billing_handle"`
+	Period          types.Int64             `tfsdk:"period"`
+	IsLocked        types.Bool              `tfsdk:"is_locked"`
+	WhoisPrivacy    types.Bool              `tfsdk:"whois_privacy"`
+	PrivacyStatus   types.String            `tfsdk:"whois_privacy_status"`
+	CreationDate    types.String            `tfsdk:"creation_date"`
+	ActiveDate      types.String            `tfsdk:"active_date"`
+	ExpirationDate  types.String            `tfsdk:"expiration_date"`
+	IsAbusive       types.Bool              `tfsdk:"is_abusive"`
+	CanRenew        types.Bool              `tfsdk:"can_renew"`
+	Nameservers     []DomainNameserverModel `tfsdk:"name_servers"`
+	NSGroup         types.String            `tfsdk:"ns_group"`
+	DnssecKeys      types.List              `tfsdk:"dnssec_keys"`
+	IsDnssecEnabled types.Bool              `tfsdk:"is_dnssec_enabled"`
+}
+
+// DomainNameserverModel represents a domain nameserver in Terraform state.
+type DomainNameserverModel struct {
+	Name types.String `tfsdk:"name"`
+	IP   types.String `tfsdk:"ip"`
+	IP6  types.String `tfsdk:"ip6"`
 }
 
 // DnssecKeyModel represents a DNSSEC key in Terraform state.
@@ -31,3 +100,11 @@ type DnssecKeyModel struct {
 	Protocol  types.Int64  `tfsdk:"protocol"`
 	PublicKey types.String `tfsdk:"public_key"`
 }
+
+// DSRecordModel represents a DS record in Terraform state.
+type DSRecordModel struct {
+	KeyTag     types.Int64  `tfsdk:"key_tag"`
+	Algorithm  types.Int64  `tfsdk:"algorithm"`
+	DigestType types.Int64  `tfsdk:"digest_type"`
+	Digest     types.String `tfsdk:"digest"`
+}
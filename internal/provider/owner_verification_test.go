@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOwnerVerificationStatus(t *testing.T) {
+	testCases := []struct {
+		verification *customers.EmailVerification
+		expected     string
+	}{
+		{nil, "none"},
+		{&customers.EmailVerification{Status: customers.VerificationStatusVerified}, "verified"},
+		{&customers.EmailVerification{Status: customers.VerificationStatusInProgress}, "in_progress"},
+		{&customers.EmailVerification{Status: customers.VerificationStatusNotVerified}, "not_verified"},
+		{&customers.EmailVerification{Status: customers.VerificationStatusFailed}, "failed"},
+	}
+
+	for _, tc := range testCases {
+		if got := ownerVerificationStatus(tc.verification); got != tc.expected {
+			t.Errorf("Expected %s for %+v, got %s", tc.expected, tc.verification, got)
+		}
+	}
+}
+
+func TestUnverifiedDomainsDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	d := NewUnverifiedDomainsDataSource()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	for _, attr := range []string{"id", "email", "domains"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Expected attribute %s not found in schema", attr)
+		}
+	}
+}
+
+func TestDomainOwnerVerificationResendAction(t *testing.T) {
+	ctx := context.Background()
+
+	var restarted []string
+	c := newFakeAPIClient(t, fakeAPI{
+		"GET /v1beta/customers/verifications/emails/domains": func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("domain") == "pending.com" {
+				_, _ = fmt.Fprint(w, `{"code": 0, "data": {"results": [{"domain": "pending.com", "email": "owner@example.com", "status": "in progress"}]}}`)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"results": []}}`)
+		},
+		"POST /v1beta/customers/verifications/emails/restart": func(w http.ResponseWriter, r *http.Request) {
+			var req customers.RestartEmailVerificationRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			restarted = append(restarted, req.Email)
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"success": true}}`)
+		},
+	})
+	a := &DomainOwnerVerificationResendAction{client: c}
+	schemaResp := &action.SchemaResponse{}
+	a.Schema(ctx, action.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	invoke := func(domainName string) *action.InvokeResponse {
+		resp := &action.InvokeResponse{}
+		a.Invoke(ctx, action.InvokeRequest{Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"domain": tftypes.NewValue(tftypes.String, domainName),
+			}),
+		}}, resp)
+		return resp
+	}
+
+	if resp := invoke("pending.com"); resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected errors: %v", resp.Diagnostics)
+	}
+	if len(restarted) != 1 || restarted[0] != "owner@example.com" {
+		t.Errorf("Expected verification email resent to owner@example.com, got %v", restarted)
+	}
+
+	if resp := invoke("verified.com"); !resp.Diagnostics.HasError() {
+		t.Error("Expected an error for a domain without pending verification")
+	}
+}
+
+func TestDomainResourceMapOwnerVerificationStatus(t *testing.T) {
+	lookups := 0
+	r := &DomainResource{client: newFakeAPIClient(t, fakeAPI{
+		"GET /v1beta/customers/verifications/emails/domains": func(w http.ResponseWriter, _ *http.Request) {
+			lookups++
+			_, _ = fmt.Fprint(w, `{"code": 0, "data": {"results": [{"domain": "example.com", "email": "owner@example.com", "status": "in progress"}]}}`)
+		},
+	})}
+
+	testCases := []struct {
+		name         string
+		ownerChanged bool
+		current      types.String
+		expectLookup bool
+		expected     string
+	}{
+		{"verified owner", false, types.StringValue("verified"), false, "verified"},
+		{"changed owner", true, types.StringValue("verified"), true, "in_progress"},
+		{"pending verification", false, types.StringValue("in_progress"), true, "in_progress"},
+		{"unknown status", false, types.StringUnknown(), true, "in_progress"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			lookups = 0
+			var diags diag.Diagnostics
+			got := r.mapOwnerVerificationStatus("example.com", tc.ownerChanged, tc.current, &diags)
+
+			if (lookups > 0) != tc.expectLookup {
+				t.Errorf("Expected lookup: %v, got %d lookups", tc.expectLookup, lookups)
+			}
+			if got.ValueString() != tc.expected {
+				t.Errorf("Expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
@@ -3,15 +3,26 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ provider.Provider                       = &OpenproviderProvider{}
+	_ provider.ProviderWithEphemeralResources = &OpenproviderProvider{}
+	_ provider.ProviderWithActions            = &OpenproviderProvider{}
+)
+
 // OpenproviderProvider defines the provider implementation.
 // OpenproviderProvider implements the Terraform provider and holds provider-level configuration.
 type OpenproviderProvider struct {
@@ -21,8 +32,26 @@ type OpenproviderProvider struct {
 
 // OpenproviderProviderModel describes the provider data model.
 type OpenproviderProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	ExpiryWarningDays  types.Int64  `tfsdk:"expiry_warning_days"`
+	FailOnExpiryWithin types.Int64  `tfsdk:"fail_on_expiry_within"`
+}
+
+// defaultExpiryWarningDays is the expiry window used when expiry_warning_days is not set.
+const defaultExpiryWarningDays = 30
+
+// DomainGuardrails holds the provider-level thresholds for the domain plan checks.
+// A zero value disables the corresponding check.
+type DomainGuardrails struct {
+	ExpiryWarningDays  int64
+	FailOnExpiryWithin int64
+}
+
+// ResourceData is handed to resources when the provider is configured.
+type ResourceData struct {
+	Client           *client.Client
+	DomainGuardrails DomainGuardrails
 }
 
 // Metadata sets the provider type name and version.
@@ -44,6 +73,14 @@ func (p *OpenproviderProvider) Schema(_ context.Context, _ provider.SchemaReques
 				Required:            true,
 				Sensitive:           true,
 			},
+			"expiry_warning_days": schema.Int64Attribute{
+				MarkdownDescription: "Warn at plan time about managed domains that expire within this number of days. Defaults to `30`; set to `0` to disable the warning.",
+				Optional:            true,
+			},
+			"fail_on_expiry_within": schema.Int64Attribute{
+				MarkdownDescription: "Fail the plan for managed domains that expire within this number of days and do not have autorenew enabled, instead of only warning. Disabled by default.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -76,6 +113,29 @@ func (p *OpenproviderProvider) Configure(ctx context.Context, req provider.Confi
 		)
 	}
 
+	guardrails := DomainGuardrails{ExpiryWarningDays: defaultExpiryWarningDays}
+	if !data.ExpiryWarningDays.IsNull() && !data.ExpiryWarningDays.IsUnknown() {
+		guardrails.ExpiryWarningDays = data.ExpiryWarningDays.ValueInt64()
+	}
+	if !data.FailOnExpiryWithin.IsNull() && !data.FailOnExpiryWithin.IsUnknown() {
+		guardrails.FailOnExpiryWithin = data.FailOnExpiryWithin.ValueInt64()
+	}
+
+	if guardrails.ExpiryWarningDays < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiry_warning_days"),
+			"Invalid Expiry Window",
+			fmt.Sprintf("expiry_warning_days must not be negative, got: %d", guardrails.ExpiryWarningDays),
+		)
+	}
+	if guardrails.FailOnExpiryWithin < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fail_on_expiry_within"),
+			"Invalid Expiry Window",
+			fmt.Sprintf("fail_on_expiry_within must not be negative, got: %d", guardrails.FailOnExpiryWithin),
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -88,7 +148,9 @@ func (p *OpenproviderProvider) Configure(ctx context.Context, req provider.Confi
 
 	// Make client available
 	resp.DataSourceData = c
-	resp.ResourceData = c
+	resp.ResourceData = &ResourceData{Client: c, DomainGuardrails: guardrails}
+	resp.EphemeralResourceData = c
+	resp.ActionData = c
 }
 
 // Resources returns the provider's resources.
@@ -99,6 +161,7 @@ func (p *OpenproviderProvider) Resources(_ context.Context) []func() resource.Re
 		NewNSGroupResource,
 		NewDNSRecordResource,
 		NewSSLOrderResource,
+		NewSSLCSRResource,
 	}
 }
 
@@ -107,9 +170,32 @@ func (p *OpenproviderProvider) DataSources(_ context.Context) []func() datasourc
 	return []func() datasource.DataSource{
 		NewCustomerDataSource,
 		NewDomainDataSource,
+		NewDomainsDataSource,
 		NewNSGroupDataSource,
 		NewDNSZoneDataSource,
 		NewSSLProductDataSource,
+		NewSSLProductsDataSource,
+		NewSSLOrderDataSource,
+		NewSSLOrdersDataSource,
+		NewSSLApproverEmailsDataSource,
+		NewTLDDataSource,
+		NewTLDsDataSource,
+		NewUnverifiedDomainsDataSource,
+	}
+}
+
+// EphemeralResources returns the provider's ephemeral resources.
+func (p *OpenproviderProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDomainAuthCodeEphemeralResource,
+	}
+}
+
+// Actions returns the provider's actions.
+func (p *OpenproviderProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewDomainAuthCodeResetAction,
+		NewDomainOwnerVerificationResendAction,
 	}
 }
 
@@ -124,6 +124,29 @@ func (r *CustomerResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					},
 				},
 			},
+			"additional_data": schema.SingleNestedBlock{
+				MarkdownDescription: "Registry-specific customer data, such as the identification numbers required when the customer is the owner of `.es` or `.it` domains.",
+				Attributes: map[string]schema.Attribute{
+					"birth_date": schema.StringAttribute{
+						MarkdownDescription: "Date of birth (YYYY-MM-DD).",
+						Optional:            true,
+					},
+					"company_registration_number": schema.StringAttribute{
+						MarkdownDescription: "Company registration number (e.g., Chamber of Commerce number).",
+						Optional:            true,
+					},
+					"passport_number": schema.StringAttribute{
+						MarkdownDescription: "Passport or identity card number.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"social_security_number": schema.StringAttribute{
+						MarkdownDescription: "Social security or tax identification number (e.g., NIF/NIE for .es, codice fiscale for .it).",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
 			"name": schema.SingleNestedBlock{
 				MarkdownDescription: "The customer's name.",
 				Attributes: map[string]schema.Attribute{
@@ -155,16 +178,16 @@ func (r *CustomerResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -250,6 +273,9 @@ func (r *CustomerResource) Create(ctx context.Context, req resource.CreateReques
 		createReq.Name.Prefix = plan.Name.Prefix.ValueString()
 	}
 
+	// Set registry-specific additional data
+	createReq.AdditionalData = convertCustomerAdditionalDataToAPI(plan.AdditionalData)
+
 	// Create the customer
 	handle, err := customers.Create(r.client, createReq)
 	if err != nil {
@@ -296,8 +322,12 @@ func (r *CustomerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	// Map to state
+	// Map to state, keeping the configured additional data when it is not reported
+	additionalData := state.AdditionalData
 	state = *mapCustomerToModel(customer)
+	if state.AdditionalData == nil {
+		state.AdditionalData = additionalData
+	}
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -403,6 +433,22 @@ func (r *CustomerResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 	}
 
+	// Update additional data if changed
+	if plan.AdditionalData != nil {
+		additionalDataChanged := state.AdditionalData == nil ||
+			!plan.AdditionalData.BirthDate.Equal(state.AdditionalData.BirthDate) ||
+			!plan.AdditionalData.CompanyRegistrationNumber.Equal(state.AdditionalData.CompanyRegistrationNumber) ||
+			!plan.AdditionalData.PassportNumber.Equal(state.AdditionalData.PassportNumber) ||
+			!plan.AdditionalData.SocialSecurityNumber.Equal(state.AdditionalData.SocialSecurityNumber)
+
+		if additionalDataChanged {
+			updateReq.AdditionalData = convertCustomerAdditionalDataToAPI(plan.AdditionalData)
+		}
+	} else if state.AdditionalData != nil {
+		// Clear the additional data when the block is removed
+		updateReq.AdditionalData = &customers.AdditionalData{}
+	}
+
 	// Send update
 	err := customers.Update(r.client, handle, updateReq)
 	if err != nil {
@@ -3,11 +3,16 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/customers"
 	"github.com/charpand/terraform-provider-openprovider/internal/client/domains"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,15 +22,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &DomainResource{}
-	_ resource.ResourceWithConfigure   = &DomainResource{}
-	_ resource.ResourceWithImportState = &DomainResource{}
+	_ resource.Resource                   = &DomainResource{}
+	_ resource.ResourceWithConfigure      = &DomainResource{}
+	_ resource.ResourceWithImportState    = &DomainResource{}
+	_ resource.ResourceWithValidateConfig = &DomainResource{}
+	_ resource.ResourceWithModifyPlan     = &DomainResource{}
 )
 
 // dnssecKeysAttrTypes defines the attribute types for DNSSEC keys.
@@ -37,9 +46,31 @@ var dnssecKeysAttrTypes = map[string]attr.Type{
 	"public_key": types.StringType,
 }
 
+// Deletion policies supported by the deletion_policy attribute.
+const (
+	// deletionPolicyError refuses to destroy the domain.
+	deletionPolicyError = "error"
+	// deletionPolicyAbandon removes the domain from Terraform state only.
+	deletionPolicyAbandon = "abandon"
+	// deletionPolicyDelete deletes the domain at the registry.
+	deletionPolicyDelete = "delete"
+)
+
+// addGracePeriod is the period after registration during which most registries
+// refund the registration fee when the domain is deleted.
+const addGracePeriod = 5 * 24 * time.Hour
+
+// defaultTransferTimeout is how long Create waits for a transfer to complete when
+// wait_for_transfer is enabled and no create timeout is configured.
+const defaultTransferTimeout = 60 * time.Minute
+
+// transferPollInterval is the delay between status checks while waiting for a transfer.
+var transferPollInterval = 30 * time.Second
+
 // DomainResource is the resource implementation.
 type DomainResource struct {
-	client *client.Client
+	client     *client.Client
+	guardrails DomainGuardrails
 }
 
 // convertDnssecKeysToAPI converts DNSSEC keys from Terraform state to API format.
@@ -66,6 +97,30 @@ func convertDnssecKeysToAPI(ctx context.Context, keysList types.List, diags *dia
 	return apiKeys
 }
 
+// convertNameserversToAPI converts domain nameservers from Terraform configuration to API format.
+func convertNameserversToAPI(ctx context.Context, nsList types.List, diags *diag.Diagnostics) []domains.Nameserver {
+	if nsList.IsNull() || nsList.IsUnknown() || len(nsList.Elements()) == 0 {
+		return nil
+	}
+
+	var nameservers []DomainNameserverModel
+	diags.Append(nsList.ElementsAs(ctx, &nameservers, false)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	apiNameservers := make([]domains.Nameserver, 0, len(nameservers))
+	for i, ns := range nameservers {
+		apiNameservers = append(apiNameservers, domains.Nameserver{
+			Name:  ns.Name.ValueString(),
+			IP:    ns.IP.ValueString(),
+			IP6:   ns.IP6.ValueString(),
+			SeqNr: i,
+		})
+	}
+	return apiNameservers
+}
+
 // mapDnssecKeysToState converts DNSSEC keys from API format to Terraform state.
 func mapDnssecKeysToState(ctx context.Context, keys []domains.DnssecKey, diags *diag.Diagnostics) types.List {
 	if len(keys) == 0 {
@@ -101,7 +156,7 @@ func (r *DomainResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // Schema defines the schema for the resource.
-func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an OpenProvider domain. Supports both domain registration and domain transfer. To transfer a domain, provide an auth_code.",
 		Attributes: map[string]schema.Attribute{
@@ -128,9 +183,29 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"status": schema.StringAttribute{
-				MarkdownDescription: "The current status of the domain. Common values: REQ (transfer requested), ACT (active/completed).",
+				MarkdownDescription: "The current status of the domain. Common values: REQ (transfer requested), ACT (active/completed), FAI (failed).",
 				Computed:            true,
 			},
+			"wait_for_transfer": schema.BoolAttribute{
+				MarkdownDescription: "Wait for a transfer to complete before finishing the apply. The domain is polled until it reaches `ACT` or a failure status, bounded by the `create` timeout (default 60m). Only applicable when `auth_code` is set.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"transfer_status": schema.StringAttribute{
+				MarkdownDescription: "The transfer lifecycle state for transferred domains: `pending`, `completed` or `failed`. Stays `completed` once the transfer has completed. Null for registered domains.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"transfer_approver_email": schema.StringAttribute{
+				MarkdownDescription: "The email address the transfer approval (FOA) email was sent to, when reported by the registry.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"autorenew": schema.BoolAttribute{
 				MarkdownDescription: "Whether the domain should auto-renew.",
 				Optional:            true,
@@ -138,7 +213,7 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Default:             booldefault.StaticBool(false),
 			},
 			"owner_handle": schema.StringAttribute{
-				MarkdownDescription: "The owner contact handle for the domain.",
+				MarkdownDescription: "The owner contact handle for the domain. Changing it on an existing domain requires `allow_owner_change`.",
 				Required:            true,
 			},
 			"admin_handle": schema.StringAttribute{
@@ -201,10 +276,206 @@ func (r *DomainResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"managed_dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Sign the domain's zone on OpenProvider DNS and publish its keys at the registry automatically, instead of configuring `dnssec_keys`. Requires the zone to be hosted on OpenProvider DNS. Key rollovers are detected on refresh and the new keys are published on the next apply. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dnskey_records": schema.ListNestedAttribute{
+				MarkdownDescription: "The key signing keys of the zone when `managed_dnssec` is enabled.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"algorithm": schema.Int64Attribute{
+							MarkdownDescription: "The algorithm number.",
+							Computed:            true,
+						},
+						"flags": schema.Int64Attribute{
+							MarkdownDescription: "The flags field.",
+							Computed:            true,
+						},
+						"protocol": schema.Int64Attribute{
+							MarkdownDescription: "The protocol field.",
+							Computed:            true,
+						},
+						"public_key": schema.StringAttribute{
+							MarkdownDescription: "The public key.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ds_records": schema.ListNestedAttribute{
+				MarkdownDescription: "The SHA-256 DS records of `dnskey_records`, as published at the registry.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_tag": schema.Int64Attribute{
+							MarkdownDescription: "The key tag of the DNSKEY.",
+							Computed:            true,
+						},
+						"algorithm": schema.Int64Attribute{
+							MarkdownDescription: "The algorithm number of the DNSKEY.",
+							Computed:            true,
+						},
+						"digest_type": schema.Int64Attribute{
+							MarkdownDescription: "The digest type (2 for SHA-256).",
+							Computed:            true,
+						},
+						"digest": schema.StringAttribute{
+							MarkdownDescription: "The hexadecimal digest.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"is_locked": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is locked against transfers at the registry (registrar lock). When unset, the current lock state is tracked without being changed. Not every TLD supports locking.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"whois_privacy": schema.BoolAttribute{
+				MarkdownDescription: "Enable WHOIS privacy protection, replacing the owner contact details in public WHOIS with OpenProvider's privacy service. When unset, the current setting is tracked without being changed. Rejected at plan time for TLDs whose registry does not permit privacy services, according to the TLD catalog.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"whois_privacy_status": schema.StringAttribute{
+				MarkdownDescription: "The WHOIS privacy state of the domain: `enabled`, `disabled` or `not_allowed` when the registry does not permit privacy services.",
+				Computed:            true,
+			},
+			"owner_verification_status": schema.StringAttribute{
+				MarkdownDescription: "The ICANN email verification status of the owner contact for this domain: `verified`, `in_progress`, `not_verified`, `failed`, or `none` when no verification is recorded. Registries suspend domains whose owner does not verify in time.",
+				Computed:            true,
+			},
 			"expiration_date": schema.StringAttribute{
 				MarkdownDescription: "The domain expiration date.",
 				Computed:            true,
 			},
+			"can_renew": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain can currently be renewed.",
+				Computed:            true,
+			},
+			"is_abusive": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain has been flagged as abusive.",
+				Computed:            true,
+			},
+			"import_nameservers_from_registry": schema.BoolAttribute{
+				MarkdownDescription: "Keep the nameservers currently registered at the registry when transferring. Only applicable when `auth_code` is set; cannot be combined with `ns_group` or `transfer_nameservers`. Changing it forces a new transfer.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"import_contacts_from_registry": schema.BoolAttribute{
+				MarkdownDescription: "Import the contacts currently registered at the registry as new customer handles when transferring. Only applicable when `auth_code` is set. Changing it forces a new transfer.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"import_dns_zone": schema.BoolAttribute{
+				MarkdownDescription: "Import the existing DNS zone of the domain into OpenProvider DNS when transferring. Only applicable when `auth_code` is set. Changing it forces a new transfer.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"transfer_nameservers": schema.ListNestedAttribute{
+				MarkdownDescription: "Nameservers to set on the domain as part of the transfer. Only applicable when `auth_code` is set; cannot be combined with `ns_group`. Changing it forces a new transfer.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The hostname of the nameserver (e.g., ns1.example.com).",
+							Required:            true,
+						},
+						"ip": schema.StringAttribute{
+							MarkdownDescription: "The IPv4 glue address, required for nameservers inside the domain itself.",
+							Optional:            true,
+						},
+						"ip6": schema.StringAttribute{
+							MarkdownDescription: "The IPv6 glue address.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"restore_if_expired": schema.BoolAttribute{
+				MarkdownDescription: "Restore the domain from redemption when it is found expired (`EXP`) or deleted (`DEL`) at the registry. The restore is planned as an update and the restore fee is shown as a plan warning.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"allow_owner_change": schema.BoolAttribute{
+				MarkdownDescription: "Allow changes to `owner_handle` on an existing domain. Owner changes are submitted as a registrant change, or as a paid trade for registries that require one, and usually need approval by the old and new registrant before they take effect. Without this, changing `owner_handle` is rejected at plan time.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"deletion_policy": schema.StringAttribute{
+				MarkdownDescription: "What happens to the domain when the resource is destroyed: `error` (default) refuses to destroy it, `abandon` removes it from Terraform state only and keeps it registered, `delete` deletes it at the registry. Deletion is only allowed within the 5-day add-grace period after registration unless `force_delete` is set.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(deletionPolicyError),
+			},
+			"force_delete": schema.BoolAttribute{
+				MarkdownDescription: "Allow `deletion_policy = \"delete\"` to delete the domain after the add-grace period. The registration fee is not refunded and the domain may become available to others.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"additional_data": schema.SingleNestedAttribute{
+				MarkdownDescription: "Registry-specific data required to register domains under some extensions, such as `nexus_category` and `application_purpose` for `.us`. The attributes required by the registry are checked at plan time against the TLD catalog (see `required_additional_data` on the `openprovider_tld` data source).",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"trustee_service": schema.BoolAttribute{
+						MarkdownDescription: "Use OpenProvider's trustee (local presence) service for registries that require a local contact.",
+						Optional:            true,
+					},
+					"nexus_category": schema.StringAttribute{
+						MarkdownDescription: "The `.us` nexus category of the registrant: `C11`, `C12`, `C21`, `C31` or `C32`.",
+						Optional:            true,
+					},
+					"application_purpose": schema.StringAttribute{
+						MarkdownDescription: "The `.us` application purpose: `P1` to `P5`.",
+						Optional:            true,
+					},
+					"legal_type": schema.StringAttribute{
+						MarkdownDescription: "The CIRA legal type of the `.ca` registrant (e.g. `CCT` for Canadian citizens, `CCO` for Canadian corporations).",
+						Optional:            true,
+					},
+					"entity_type": schema.StringAttribute{
+						MarkdownDescription: "The `.it` registrant entity type: `1` (natural person) to `7` (other subjects).",
+						Optional:            true,
+					},
+					"id_number": schema.StringAttribute{
+						MarkdownDescription: "The registrant identification number, such as the NIF/NIE for `.es` or the codice fiscale for `.it`.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
 		},
 	}
 }
@@ -215,16 +486,368 @@ func (r *DomainResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.guardrails = data.DomainGuardrails
+}
+
+// ValidateConfig validates the deletion policy, that WHOIS privacy and additional_data
+// match the requirements of the TLD and that transfer-only attributes are used together
+// with auth_code.
+func (r *DomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DomainModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.DeletionPolicy.IsNull() && !config.DeletionPolicy.IsUnknown() {
+		switch policy := config.DeletionPolicy.ValueString(); policy {
+		case deletionPolicyError, deletionPolicyAbandon, deletionPolicyDelete:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deletion_policy"),
+				"Invalid Deletion Policy",
+				fmt.Sprintf("deletion_policy must be one of %q, %q or %q, got: %q", deletionPolicyError, deletionPolicyAbandon, deletionPolicyDelete, policy),
+			)
+		}
+	}
+
+	if config.ForceDelete.ValueBool() && !config.DeletionPolicy.IsUnknown() && config.DeletionPolicy.ValueString() != deletionPolicyDelete {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("force_delete"),
+			"Force Delete Without Delete Policy",
+			"force_delete only applies when deletion_policy is \"delete\".",
+		)
+	}
+
+	if config.ManagedDnssec.ValueBool() {
+		if !config.DnssecKeys.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("dnssec_keys"),
+				"Conflicting DNSSEC Configuration",
+				"dnssec_keys cannot be set when managed_dnssec is enabled, as the keys are taken from the signed zone.",
+			)
+		}
+		if !config.IsDnssecEnabled.IsNull() && !config.IsDnssecEnabled.IsUnknown() && !config.IsDnssecEnabled.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("is_dnssec_enabled"),
+				"Conflicting DNSSEC Configuration",
+				"is_dnssec_enabled cannot be false when managed_dnssec is enabled.",
+			)
+		}
+	}
+
+	validateAdditionalData(config.AdditionalData, &resp.Diagnostics)
+
+	// auth_code may come from a variable that is not known yet
+	if config.AuthCode.IsUnknown() {
+		return
+	}
+
+	isTransfer := !config.AuthCode.IsNull() && config.AuthCode.ValueString() != ""
+
+	transferOnly := []struct {
+		name  string
+		isSet bool
+	}{
+		{"wait_for_transfer", config.WaitForTransfer.ValueBool()},
+		{"import_nameservers_from_registry", config.ImportNameserversFromRegistry.ValueBool()},
+		{"import_contacts_from_registry", config.ImportContactsFromRegistry.ValueBool()},
+		{"import_dns_zone", config.ImportDNSZone.ValueBool()},
+		{"transfer_nameservers", !config.TransferNameservers.IsNull()},
+	}
+
+	if !isTransfer {
+		for _, attribute := range transferOnly {
+			if attribute.isSet {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute.name),
+					"Transfer-Only Attribute",
+					fmt.Sprintf("%s only applies to domain transfers. Set auth_code to transfer the domain, or remove %s.", attribute.name, attribute.name),
+				)
+			}
+		}
+		return
+	}
+
+	hasNSGroup := !config.NSGroup.IsNull() && config.NSGroup.ValueString() != ""
+	hasTransferNameservers := !config.TransferNameservers.IsNull()
+
+	if hasNSGroup && hasTransferNameservers {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("transfer_nameservers"),
+			"Conflicting Nameserver Configuration",
+			"transfer_nameservers cannot be combined with ns_group. Use one of them to set the nameservers of the transferred domain.",
+		)
+	}
+
+	if config.ImportNameserversFromRegistry.ValueBool() && (hasNSGroup || hasTransferNameservers) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("import_nameservers_from_registry"),
+			"Conflicting Nameserver Configuration",
+			"import_nameservers_from_registry keeps the nameservers currently registered at the registry and cannot be combined with ns_group or transfer_nameservers.",
+		)
+	}
+}
+
+// ModifyPlan rejects owner changes that were not opted into, reports domains at risk
+// of expiring, plans a restore for domains in redemption when restore_if_expired is
+// set, and rejects configurations the registry cannot fulfil, based on the TLD
+// catalog. Once the provider is configured, the catalog is consulted for every new
+// domain, as the period and the required additional data always depend on it, and
+// on updates that change a capability-dependent attribute.
+func (r *DomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DomainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Domain.IsUnknown() {
+		return
+	}
+
+	// A replacement registers a new domain, so it is checked like a create: it is
+	// neither an owner change nor an update of the expiring domain.
+	isCreate := req.State.Raw.IsNull() || len(resp.RequiresReplace) > 0
+	if !isCreate {
+		var state DomainModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !plan.OwnerHandle.IsUnknown() && !plan.OwnerHandle.Equal(state.OwnerHandle) {
+			if !plan.AllowOwnerChange.ValueBool() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("owner_handle"),
+					"Owner Change Not Allowed",
+					fmt.Sprintf("Changing the owner of domain %s from %s to %s transfers the legal ownership of the domain. "+
+						"Depending on the registry it is charged as a trade and must be approved by the old and new registrant. "+
+						"Set allow_owner_change = true to perform the owner change, or revert owner_handle.",
+						plan.Domain.ValueString(), state.OwnerHandle.ValueString(), plan.OwnerHandle.ValueString()),
+				)
+				return
+			}
+
+			if r.client != nil {
+				r.planOwnerChange(plan.Domain.ValueString(), resp)
+			}
+		}
+
+		restorePlanned := isInRedemption(state.Status.ValueString()) && plan.RestoreIfExpired.ValueBool()
+		checkDomainGuardrails(state, plan.Autorenew.ValueBool(), restorePlanned, r.guardrails, time.Now(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Republish the zone's keys when managed DNSSEC is toggled or the keys were rolled over
+		if !plan.ManagedDnssec.IsUnknown() && (!plan.ManagedDnssec.Equal(state.ManagedDnssec) ||
+			plan.ManagedDnssec.ValueBool() && !publishedKeysMatch(ctx, state.DnssecKeys, state.DnskeyRecords)) {
+			planManagedDnssec(ctx, req.Config, resp)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		// The remaining checks need the API
+		if r.client == nil {
+			return
+		}
+
+		if restorePlanned {
+			r.planRestore(ctx, plan.Domain.ValueString(), resp)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if plan.WhoisPrivacy.Equal(state.WhoisPrivacy) &&
+			plan.IsLocked.Equal(state.IsLocked) &&
+			plan.ManagedDnssec.Equal(state.ManagedDnssec) &&
+			plan.IsDnssecEnabled.Equal(state.IsDnssecEnabled) &&
+			plan.DnssecKeys.Equal(state.DnssecKeys) {
+			return
+		}
+	}
+
+	// The catalog is only available once the provider is configured
+	if r.client == nil {
+		return
+	}
+
+	extension := domainExtension(plan.Domain.ValueString())
+	tld, err := tlds.Get(r.client, extension)
+	if isCreate && errors.Is(err, tlds.ErrNotFound) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("domain"),
+			"Unsupported TLD",
+			fmt.Sprintf("Openprovider does not offer the .%s TLD, so %s cannot be registered or transferred.", extension, plan.Domain.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Verify TLD Capabilities",
+			fmt.Sprintf("Could not read the .%s TLD catalog entry, so the configuration was not checked against the registry: %s", extension, err.Error()),
+		)
+		return
+	}
+
+	validateTLDCapabilities(plan, isCreate, tld, &resp.Diagnostics)
+}
+
+// checkDomainGuardrails reports managed domains that are at risk of being lost:
+// domains expiring within the configured window, domains that cannot be renewed,
+// domains flagged as abusive and domains that are not active. autorenew is the
+// planned autorenew setting. Only expiry within fail_on_expiry_within without
+// autorenew is an error; everything else is a warning.
+func checkDomainGuardrails(state DomainModel, autorenew, restorePlanned bool, guardrails DomainGuardrails, now time.Time, diags *diag.Diagnostics) {
+	domainName := state.Domain.ValueString()
+
+	// A planned restore renews the domain and is reported by planRestore
+	if expirationDate := state.ExpirationDate.ValueString(); expirationDate != "" && !restorePlanned {
+		expires, err := parseDomainDate(expirationDate)
+		if err == nil {
+			renewal := "autorenew is disabled, so the domain will be lost unless it is renewed manually"
+			if autorenew {
+				renewal = "autorenew is enabled; make sure the account balance covers the renewal"
+			}
+
+			switch {
+			case guardrails.FailOnExpiryWithin > 0 && expires.Before(now.AddDate(0, 0, int(guardrails.FailOnExpiryWithin))) && autorenew:
+				diags.AddAttributeWarning(
+					path.Root("expiration_date"),
+					"Domain Expiring",
+					fmt.Sprintf("Domain %s expires on %s, within the %d days set by fail_on_expiry_within; %s.",
+						domainName, expirationDate, guardrails.FailOnExpiryWithin, renewal),
+				)
+			case guardrails.FailOnExpiryWithin > 0 && expires.Before(now.AddDate(0, 0, int(guardrails.FailOnExpiryWithin))):
+				diags.AddAttributeError(
+					path.Root("expiration_date"),
+					"Domain Expiring",
+					fmt.Sprintf("Domain %s expires on %s, within the %d days set by fail_on_expiry_within; %s.",
+						domainName, expirationDate, guardrails.FailOnExpiryWithin, renewal),
+				)
+			case guardrails.ExpiryWarningDays > 0 && expires.Before(now.AddDate(0, 0, int(guardrails.ExpiryWarningDays))):
+				diags.AddAttributeWarning(
+					path.Root("expiration_date"),
+					"Domain Expiring",
+					fmt.Sprintf("Domain %s expires on %s, within the %d days set by expiry_warning_days; %s.",
+						domainName, expirationDate, guardrails.ExpiryWarningDays, renewal),
+				)
+			}
+		}
+	}
+
+	if !state.CanRenew.IsNull() && !state.CanRenew.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("can_renew"),
+			"Domain Cannot Be Renewed",
+			fmt.Sprintf("The registry does not currently allow domain %s to be renewed. Check the domain in the OpenProvider control panel.", domainName),
+		)
+	}
+
+	if state.IsAbusive.ValueBool() {
+		diags.AddAttributeWarning(
+			path.Root("is_abusive"),
+			"Domain Flagged as Abusive",
+			fmt.Sprintf("Domain %s has been flagged as abusive and may be suspended by the registry.", domainName),
+		)
+	}
+
+	if status := state.Status.ValueString(); status != "" && status != "ACT" && !restorePlanned {
+		diags.AddAttributeWarning(
+			path.Root("status"),
+			"Domain Not Active",
+			fmt.Sprintf("Domain %s has status %s instead of ACT.", domainName, status),
+		)
+	}
+}
+
+// planManagedDnssec marks the DNSSEC attributes as changing so the zone's keys are
+// published, or removed, in Update. Configured values are left untouched.
+func planManagedDnssec(ctx context.Context, config tfsdk.Config, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dnskey_records"), types.ListUnknown(types.ObjectType{AttrTypes: dnssecKeysAttrTypes}))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ds_records"), types.ListUnknown(types.ObjectType{AttrTypes: dsRecordsAttrTypes}))...)
+
+	var dnssecKeys types.List
+	resp.Diagnostics.Append(config.GetAttribute(ctx, path.Root("dnssec_keys"), &dnssecKeys)...)
+	if dnssecKeys.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dnssec_keys"), types.ListUnknown(types.ObjectType{AttrTypes: dnssecKeysAttrTypes}))...)
+	}
+
+	var isDnssecEnabled types.Bool
+	resp.Diagnostics.Append(config.GetAttribute(ctx, path.Root("is_dnssec_enabled"), &isDnssecEnabled)...)
+	if isDnssecEnabled.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_dnssec_enabled"), types.BoolUnknown())...)
+	}
+}
+
+// planRestore marks the domain status as changing so the restore is applied in
+// Update, and surfaces the restore fee as a plan warning.
+func (r *DomainResource) planRestore(ctx context.Context, domainName string, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+
+	fee := "unknown"
+	name, extension, _ := splitDomainName(domainName)
+	price, err := domains.GetPrice(r.client, name, extension, domains.OperationRestore)
+	if err == nil && price.Price.Reseller.Currency != "" {
+		fee = fmt.Sprintf("%.2f %s", price.Price.Reseller.Price, price.Price.Reseller.Currency)
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Domain Restore Planned",
+		fmt.Sprintf("Domain %s is in redemption and will be restored on apply because restore_if_expired is set. "+
+			"The registry charges a restore fee (%s) in addition to the renewal.", domainName, fee),
+	)
+}
+
+// planOwnerChange surfaces how an opted-in owner change will be carried out: as a
+// paid trade, including its fee, or as a registrant change that needs approval.
+func (r *DomainResource) planOwnerChange(domainName string, resp *resource.ModifyPlanResponse) {
+	extension := domainExtension(domainName)
+	tld, err := tlds.Get(r.client, extension)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Owner Change Planned",
+			fmt.Sprintf("The owner of domain %s will be changed on apply. Could not read the .%s TLD catalog entry to determine "+
+				"whether the registry charges a trade fee: %s", domainName, extension, err.Error()),
+		)
+		return
+	}
+
+	if !tld.IsTradeRequired {
+		resp.Diagnostics.AddWarning(
+			"Owner Change Planned",
+			fmt.Sprintf("The owner of domain %s will be changed on apply. The registry may require the old and new registrant "+
+				"to confirm the change by email, and may lock the domain against transfers for 60 days afterwards.", domainName),
 		)
 		return
 	}
 
-	r.client = client
+	fee := "unknown"
+	name, _, _ := splitDomainName(domainName)
+	price, err := domains.GetPrice(r.client, name, extension, domains.OperationTrade)
+	if err == nil && price.Price.Reseller.Currency != "" {
+		fee = fmt.Sprintf("%.2f %s", price.Price.Reseller.Price, price.Price.Reseller.Currency)
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Domain Trade Planned",
+		fmt.Sprintf("The .%s registry handles owner changes as a trade. Domain %s will be traded to the new owner on apply, "+
+			"which is charged at the trade fee (%s) and completes once the new registrant approves it.", extension, domainName, fee),
+	)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -238,8 +861,8 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Parse domain name into name and extension
 	domainName := plan.Domain.ValueString()
-	parts := strings.Split(domainName, ".")
-	if len(parts) < 2 {
+	name, extension, ok := splitDomainName(domainName)
+	if !ok {
 		resp.Diagnostics.AddError(
 			"Invalid Domain Domain",
 			fmt.Sprintf("Domain name must include extension (e.g., example.com), got: %s", domainName),
@@ -247,9 +870,6 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	name := strings.Join(parts[:len(parts)-1], ".")
-	extension := parts[len(parts)-1]
-
 	var domain *domains.Domain
 	var err error
 
@@ -284,6 +904,29 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 			transferReq.NSGroup = plan.NSGroup.ValueString()
 		}
 
+		// Set nameservers to hand off to as part of the transfer
+		transferReq.Nameservers = convertNameserversToAPI(ctx, plan.TransferNameservers, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Set import options
+		transferReq.ImportNameserversFromRegistry = plan.ImportNameserversFromRegistry.ValueBool()
+		transferReq.ImportContactsFromRegistry = plan.ImportContactsFromRegistry.ValueBool()
+		transferReq.ImportDNSZone = plan.ImportDNSZone.ValueBool()
+
+		// Set WHOIS privacy if specified
+		if !plan.WhoisPrivacy.IsNull() && !plan.WhoisPrivacy.IsUnknown() {
+			enabled := plan.WhoisPrivacy.ValueBool()
+			transferReq.IsPrivateWhoisEnabled = &enabled
+		}
+
+		// Set registry-specific additional data
+		transferReq.AdditionalData = convertAdditionalDataToAPI(ctx, plan.AdditionalData, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		domain, err = domains.Transfer(r.client, transferReq)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -292,6 +935,38 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 			)
 			return
 		}
+
+		if plan.WaitForTransfer.ValueBool() {
+			createTimeout, diags := plan.Timeouts.Create(ctx, defaultTransferTimeout)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+			defer cancel()
+
+			transferred, err := waitForTransfer(waitCtx, r.client, domain.ID)
+			switch {
+			case errors.Is(err, context.DeadlineExceeded):
+				// The transfer is still running at the registry. Keep the resource in
+				// state so the next apply does not initiate a second transfer.
+				resp.Diagnostics.AddWarning(
+					"Domain Transfer Still Pending",
+					fmt.Sprintf("The transfer of %s did not complete within %s. The transfer continues at the registry; "+
+						"transfer_status is refreshed on the next plan.", domainName, createTimeout),
+				)
+			case err != nil:
+				resp.Diagnostics.AddError(
+					"Domain Transfer Failed",
+					fmt.Sprintf("The transfer of %s did not complete: %s", domainName, err.Error()),
+				)
+				return
+			}
+			if transferred != nil {
+				domain = transferred
+			}
+		}
 	} else {
 		// Domain Registration
 		createReq := &domains.CreateDomainRequest{}
@@ -343,6 +1018,18 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 			createReq.IsDnssecEnabled = &enabled
 		}
 
+		// Set WHOIS privacy if specified
+		if !plan.WhoisPrivacy.IsNull() && !plan.WhoisPrivacy.IsUnknown() {
+			enabled := plan.WhoisPrivacy.ValueBool()
+			createReq.IsPrivateWhoisEnabled = &enabled
+		}
+
+		// Set registry-specific additional data
+		createReq.AdditionalData = convertAdditionalDataToAPI(ctx, plan.AdditionalData, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		// Create the domain
 		domain, err = domains.Create(r.client, createReq)
 		if err != nil {
@@ -354,6 +1041,39 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	// The create and transfer endpoints cannot set the registrar lock, so apply
+	// it with a follow-up update.
+	lockConfigured := !plan.IsLocked.IsNull() && !plan.IsLocked.IsUnknown()
+	if lockConfigured {
+		r.applyInitialLock(domainName, domain.ID, plan.IsLocked.ValueBool(), &resp.Diagnostics)
+	}
+
+	// Sign the zone and publish its keys at the registry when DNSSEC is managed.
+	// The domain exists at this point, so failures are reported as warnings to
+	// keep it in state; the keys are then published on the next apply.
+	dnssecPublished := false
+	if plan.ManagedDnssec.ValueBool() {
+		var signingDiags diag.Diagnostics
+		if keys := r.enableZoneSigning(domainName, &signingDiags); keys != nil {
+			enabled := true
+			if _, err := domains.Update(r.client, domain.ID, &domains.UpdateDomainRequest{DnssecKeys: keys, IsDnssecEnabled: &enabled}); err != nil {
+				resp.Diagnostics.AddAttributeWarning(
+					path.Root("managed_dnssec"),
+					"DNSSEC Keys Not Yet Published",
+					fmt.Sprintf("Domain %s was created, but the DNSSEC keys of its zone could not be published at the registry: %s. "+
+						"The keys are published on the next apply.", domainName, err.Error()),
+				)
+			} else {
+				domain.DnssecKeys = keys
+				domain.IsDnssecEnabled = true
+				dnssecPublished = true
+			}
+		}
+		for _, d := range signingDiags.Errors() {
+			resp.Diagnostics.AddAttributeWarning(path.Root("managed_dnssec"), d.Summary(), d.Detail())
+		}
+	}
+
 	// Set ID to the domain name
 	plan.ID = types.StringValue(domainName)
 
@@ -395,18 +1115,93 @@ func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Map DNSSEC enabled status from response
 	plan.IsDnssecEnabled = types.BoolValue(domain.IsDnssecEnabled)
 
+	// Map the zone's signing keys once they are published. Otherwise they are read
+	// on the next refresh, which plans their publication.
+	plan.DnskeyRecords = types.ListNull(types.ObjectType{AttrTypes: dnssecKeysAttrTypes})
+	plan.DSRecords = types.ListNull(types.ObjectType{AttrTypes: dsRecordsAttrTypes})
+	if dnssecPublished {
+		r.readZoneSigningKeys(ctx, &plan, &resp.Diagnostics)
+	}
+
 	// Map expiration date if present
 	if domain.ExpirationDate != "" {
 		plan.ExpirationDate = types.StringValue(domain.ExpirationDate)
 	} else {
 		plan.ExpirationDate = types.StringNull()
 	}
+	plan.CanRenew = types.BoolValue(domain.CanRenew)
+	plan.IsAbusive = types.BoolValue(domain.IsAbusive)
+
+	// Map registrar lock from response. A configured lock keeps its planned value:
+	// when it could not be applied yet, the next refresh reports the actual state
+	// and the lock is applied by a later apply.
+	if !lockConfigured {
+		plan.IsLocked = types.BoolValue(domain.IsLocked)
+	}
+
+	// Map WHOIS privacy from response. A configured value keeps its planned value,
+	// as the create and transfer responses do not always report it yet; the next
+	// refresh reports the actual state.
+	if !plan.WhoisPrivacy.IsNull() && !plan.WhoisPrivacy.IsUnknown() {
+		domain.IsPrivateWhoisEnabled = plan.WhoisPrivacy.ValueBool()
+	}
+	plan.WhoisPrivacy = types.BoolValue(domain.IsPrivateWhoisEnabled)
+	plan.PrivacyStatus = types.StringValue(whoisPrivacyStatus(domain))
+
+	// Map owner email verification
+	plan.OwnerVerificationStatus = r.mapOwnerVerificationStatus(domainName, true, plan.OwnerVerificationStatus, &resp.Diagnostics)
+
+	// Map transfer lifecycle attributes
+	plan.TransferStatus, plan.ApproverEmail = mapTransferToState(isTransfer, domain, types.StringNull())
 
 	// Save state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
+// applyInitialLock sets the registrar lock of a domain that has just been registered
+// or transferred. The create and transfer responses do not report the lock state,
+// so the domain is read back first. Domains that are not active yet, such as
+// pending transfers, cannot be locked. The domain exists at this point, so problems
+// are reported as warnings: an error would taint the resource and register or
+// transfer the domain again on the next apply.
+func (r *DomainResource) applyInitialLock(domainName string, id int, locked bool, diags *diag.Diagnostics) {
+	domain, err := domains.Get(r.client, id)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("is_locked"),
+			"Registrar Lock Not Yet Set",
+			fmt.Sprintf("Could not read domain %s to set its registrar lock: %s. The lock is set on the next apply.", domainName, err.Error()),
+		)
+		return
+	}
+
+	switch {
+	case domain.IsLocked == locked:
+	case domain.Status != domains.StatusActive:
+		diags.AddAttributeWarning(
+			path.Root("is_locked"),
+			"Registrar Lock Not Yet Set",
+			fmt.Sprintf("Domain %s has status %s and cannot be locked until it is active, for example once its transfer has completed. "+
+				"The lock is set on a later apply.", domainName, domain.Status),
+		)
+	case !domain.IsLockable:
+		diags.AddAttributeWarning(
+			path.Root("is_locked"),
+			"Registrar Lock Not Supported",
+			fmt.Sprintf("Domain %s was created, but its TLD does not support registrar locking. Remove is_locked from the configuration.", domainName),
+		)
+	default:
+		if _, err := domains.Update(r.client, id, &domains.UpdateDomainRequest{IsLocked: &locked}); err != nil {
+			diags.AddAttributeWarning(
+				path.Root("is_locked"),
+				"Registrar Lock Not Yet Set",
+				fmt.Sprintf("Domain %s was created, but the registrar lock could not be set: %s. The lock is set on the next apply.", domainName, err.Error()),
+			)
+		}
+	}
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state DomainModel
@@ -439,7 +1234,17 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 	state.Domain = types.StringValue(domainName)
 	state.Status = types.StringValue(domain.Status)
 
+	if isInRedemption(domain.Status) && !state.RestoreIfExpired.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			"Domain In Redemption",
+			fmt.Sprintf("Domain %s has status %s and is in the redemption period at the registry. "+
+				"Set restore_if_expired = true to restore it, or restore it from the control panel before it is released.",
+				domainName, domain.Status),
+		)
+	}
+
 	// Map contact handles
+	ownerChanged := state.OwnerHandle.ValueString() != domain.OwnerHandle
 	state.OwnerHandle = types.StringValue(domain.OwnerHandle)
 	state.AdminHandle = types.StringValue(domain.AdminHandle)
 	state.TechHandle = types.StringValue(domain.TechHandle)
@@ -468,12 +1273,44 @@ func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Map DNSSEC enabled status from response
 	state.IsDnssecEnabled = types.BoolValue(domain.IsDnssecEnabled)
 
+	// Map the zone's signing keys when DNSSEC is managed
+	if state.ManagedDnssec.ValueBool() {
+		r.readZoneSigningKeys(ctx, &state, &resp.Diagnostics)
+	} else {
+		state.DnskeyRecords = types.ListNull(types.ObjectType{AttrTypes: dnssecKeysAttrTypes})
+		state.DSRecords = types.ListNull(types.ObjectType{AttrTypes: dsRecordsAttrTypes})
+	}
+
 	// Map expiration date if present
 	if domain.ExpirationDate != "" {
 		state.ExpirationDate = types.StringValue(domain.ExpirationDate)
 	} else {
 		state.ExpirationDate = types.StringNull()
 	}
+	state.CanRenew = types.BoolValue(domain.CanRenew)
+	state.IsAbusive = types.BoolValue(domain.IsAbusive)
+
+	// Map registrar lock
+	state.IsLocked = types.BoolValue(domain.IsLocked)
+
+	// Map WHOIS privacy
+	state.WhoisPrivacy = types.BoolValue(domain.IsPrivateWhoisEnabled)
+	state.PrivacyStatus = types.StringValue(whoisPrivacyStatus(domain))
+
+	// Map owner email verification
+	state.OwnerVerificationStatus = r.mapOwnerVerificationStatus(domainName, ownerChanged, state.OwnerVerificationStatus, &resp.Diagnostics)
+
+	// Map additional data when reported, keeping the configured value otherwise
+	if domain.AdditionalData != nil {
+		state.AdditionalData = mapAdditionalDataToState(ctx, domain.AdditionalData, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Map transfer lifecycle attributes
+	isTransfer := !state.AuthCode.IsNull() && state.AuthCode.ValueString() != ""
+	state.TransferStatus, state.ApproverEmail = mapTransferToState(isTransfer, domain, state.TransferStatus)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -512,19 +1349,37 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// Restore the domain first, as a domain in redemption cannot be updated
+	if isInRedemption(domain.Status) && plan.RestoreIfExpired.ValueBool() {
+		if _, err := domains.Restore(r.client, domain.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Restoring Domain",
+				fmt.Sprintf("Could not restore domain %s: %s", domainName, err.Error()),
+			)
+			return
+		}
+	}
+
+	ownerChanged := !plan.OwnerHandle.Equal(state.OwnerHandle)
+
 	// Check if there are any actual user-configured changes.
 	// Note: Handle fields (AdminHandle, TechHandle, BillingHandle) only detect changes when
 	// the plan value is non-null. This is intentional: clearing a handle (changing from value
 	// to null) is not a supported operation in the Openprovider API, so we don't detect it
 	// as a change. Users cannot use Terraform to clear handles to null. If a handle in the
 	// plan is null, it should match the state value.
-	hasChanges := (!plan.AdminHandle.Equal(state.AdminHandle) && !plan.AdminHandle.IsNull()) ||
+	fieldsChanged := (!plan.AdminHandle.Equal(state.AdminHandle) && !plan.AdminHandle.IsNull()) ||
 		(!plan.TechHandle.Equal(state.TechHandle) && !plan.TechHandle.IsNull()) ||
 		(!plan.BillingHandle.Equal(state.BillingHandle) && !plan.BillingHandle.IsNull()) ||
 		!plan.Autorenew.Equal(state.Autorenew) ||
 		!plan.NSGroup.Equal(state.NSGroup) ||
 		!plan.DnssecKeys.Equal(state.DnssecKeys) ||
-		!plan.IsDnssecEnabled.Equal(state.IsDnssecEnabled)
+		!plan.IsDnssecEnabled.Equal(state.IsDnssecEnabled) ||
+		!plan.ManagedDnssec.Equal(state.ManagedDnssec) ||
+		(!plan.IsLocked.Equal(state.IsLocked) && !plan.IsLocked.IsUnknown()) ||
+		(!plan.WhoisPrivacy.Equal(state.WhoisPrivacy) && !plan.WhoisPrivacy.IsUnknown()) ||
+		!plan.AdditionalData.Equal(state.AdditionalData)
+	hasChanges := ownerChanged || fieldsChanged
 
 	// If no changes detected, skip the API call and just refresh state to pick up any
 	// server-side changes (e.g., DNSSEC keys or other computed fields updated by the API).
@@ -544,9 +1399,39 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Create update request with only changed mutable attributes
-	// Note: OwnerHandle is not updatable (typically immutable after domain creation)
 	updateReq := &domains.UpdateDomainRequest{}
 
+	// Change the owner through a trade or a registrant change, depending on the registry
+	traded := false
+	if ownerChanged {
+		if !plan.AllowOwnerChange.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("owner_handle"),
+				"Owner Change Not Allowed",
+				fmt.Sprintf("Changing the owner of domain %s requires allow_owner_change = true.", domainName),
+			)
+			return
+		}
+
+		tld, err := tlds.Get(r.client, domainExtension(domainName))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading TLD",
+				fmt.Sprintf("Could not determine how the registry of domain %s handles owner changes: %s", domainName, err.Error()),
+			)
+			return
+		}
+
+		if tld.IsTradeRequired {
+			if !r.tradeDomain(plan, domain, resp) {
+				return
+			}
+			traded = true
+		} else {
+			updateReq.OwnerHandle = plan.OwnerHandle.ValueString()
+		}
+	}
+
 	// Update contact handles if changed
 	// Only set values if they are not null in the plan
 	if !plan.AdminHandle.Equal(state.AdminHandle) && !plan.AdminHandle.IsNull() {
@@ -580,34 +1465,96 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
-	// Update DNSSEC keys if changed
-	if !plan.DnssecKeys.Equal(state.DnssecKeys) {
-		updateReq.DnssecKeys = convertDnssecKeysToAPI(ctx, plan.DnssecKeys, &resp.Diagnostics)
+	if plan.ManagedDnssec.ValueBool() {
+		// Publish the zone's current signing keys when DNSSEC is managed
+		if !plan.DnssecKeys.Equal(state.DnssecKeys) || !plan.ManagedDnssec.Equal(state.ManagedDnssec) {
+			updateReq.DnssecKeys = r.enableZoneSigning(domainName, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			enabled := true
+			updateReq.IsDnssecEnabled = &enabled
+		}
+	} else {
+		// Update DNSSEC keys if changed
+		if !plan.DnssecKeys.Equal(state.DnssecKeys) {
+			updateReq.DnssecKeys = convertDnssecKeysToAPI(ctx, plan.DnssecKeys, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			// If nil, convert to empty slice to explicitly clear DNSSEC keys
+			if updateReq.DnssecKeys == nil {
+				updateReq.DnssecKeys = []domains.DnssecKey{}
+			}
+		}
+
+		// Update DNSSEC enabled if changed
+		if !plan.IsDnssecEnabled.Equal(state.IsDnssecEnabled) {
+			if !plan.IsDnssecEnabled.IsNull() {
+				enabled := plan.IsDnssecEnabled.ValueBool()
+				updateReq.IsDnssecEnabled = &enabled
+			}
+		}
+	}
+
+	// Update registrar lock if changed
+	if !plan.IsLocked.Equal(state.IsLocked) && !plan.IsLocked.IsNull() && !plan.IsLocked.IsUnknown() {
+		if !domain.IsLockable {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("is_locked"),
+				"Registrar Lock Not Supported",
+				fmt.Sprintf("The TLD of domain %s does not support registrar locking. Remove is_locked from the configuration.", domainName),
+			)
+			return
+		}
+		locked := plan.IsLocked.ValueBool()
+		updateReq.IsLocked = &locked
+	}
+
+	// Update WHOIS privacy if changed
+	if !plan.WhoisPrivacy.Equal(state.WhoisPrivacy) && !plan.WhoisPrivacy.IsNull() && !plan.WhoisPrivacy.IsUnknown() {
+		enabled := plan.WhoisPrivacy.ValueBool()
+		if enabled && !domain.IsPrivateWhoisAllowed {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("whois_privacy"),
+				"WHOIS Privacy Not Allowed",
+				fmt.Sprintf("WHOIS privacy is not allowed for domain %s. Remove whois_privacy or set it to false.", domainName),
+			)
+			return
+		}
+		updateReq.IsPrivateWhoisEnabled = &enabled
+	}
+
+	// Update additional data if changed
+	if !plan.AdditionalData.Equal(state.AdditionalData) {
+		updateReq.AdditionalData = convertAdditionalDataToAPI(ctx, plan.AdditionalData, &resp.Diagnostics)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		// If nil, convert to empty slice to explicitly clear DNSSEC keys
-		if updateReq.DnssecKeys == nil {
-			updateReq.DnssecKeys = []domains.DnssecKey{}
+		// Clear the additional data when the block is removed
+		if plan.AdditionalData.IsNull() {
+			updateReq.AdditionalData = &domains.AdditionalData{}
 		}
 	}
 
-	// Update DNSSEC enabled if changed
-	if !plan.IsDnssecEnabled.Equal(state.IsDnssecEnabled) {
-		if !plan.IsDnssecEnabled.IsNull() {
-			enabled := plan.IsDnssecEnabled.ValueBool()
-			updateReq.IsDnssecEnabled = &enabled
+	// Send update, unless the trade was the only change
+	if !traded || fieldsChanged {
+		_, err = domains.Update(r.client, domain.ID, updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Domain",
+				fmt.Sprintf("Could not update domain %s: %s", domainName, err.Error()),
+			)
+			return
 		}
 	}
 
-	// Send update
-	_, err = domains.Update(r.client, domain.ID, updateReq)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating Domain",
-			fmt.Sprintf("Could not update domain %s: %s", domainName, err.Error()),
-		)
-		return
+	// Stop signing the zone once its keys have been removed from the registry
+	if state.ManagedDnssec.ValueBool() && !plan.ManagedDnssec.ValueBool() {
+		r.disableZoneSigning(domainName, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	// Call Read to refresh the state
@@ -618,13 +1565,139 @@ func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest,
 	r.Read(ctx, readReq, &readResp)
 	resp.State = readResp.State
 	resp.Diagnostics.Append(readResp.Diagnostics...)
+	if resp.Diagnostics.HasError() || !ownerChanged {
+		return
+	}
+
+	// Owner changes awaiting registrant approval still report the old owner. Keep the
+	// planned owner in state, as the change has been submitted.
+	var refreshed DomainModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &refreshed)...)
+	if resp.Diagnostics.HasError() || refreshed.OwnerHandle.Equal(plan.OwnerHandle) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner_handle"), plan.OwnerHandle)...)
+	resp.Diagnostics.AddWarning(
+		"Owner Change Pending Approval",
+		fmt.Sprintf("The owner change of domain %s to %s has been submitted but the registry still reports owner %s. "+
+			"The change takes effect once it has been approved; check the registrant's email for the confirmation request. "+
+			"Until then, refreshing will report the old owner again. Do not apply the owner change a second time while it is pending.",
+			domainName, plan.OwnerHandle.ValueString(), refreshed.OwnerHandle.ValueString()),
+	)
 }
 
-// Delete prevents deletion of domains as a safety measure.
-func (r *DomainResource) Delete(_ context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
-	resp.Diagnostics.AddError(
-		"Domain Deletion Not Allowed",
-		"Domains cannot be deleted through this provider as a safety measure. Domain deletions are irreversible and must be performed manually outside of Terraform. To stop managing a domain, remove it from your Terraform configuration.",
+// tradeDomain submits an owner change as a trade for registries that require one.
+// It returns false when the trade could not be submitted.
+func (r *DomainResource) tradeDomain(plan DomainModel, domain *domains.Domain, resp *resource.UpdateResponse) bool {
+	tradeReq := &domains.TradeDomainRequest{}
+	tradeReq.Domain.Name = domain.Domain.Name
+	tradeReq.Domain.Extension = domain.Domain.Extension
+	tradeReq.OwnerHandle = plan.OwnerHandle.ValueString()
+	if !plan.AdminHandle.IsNull() && !plan.AdminHandle.IsUnknown() {
+		tradeReq.AdminHandle = plan.AdminHandle.ValueString()
+	}
+	if !plan.TechHandle.IsNull() && !plan.TechHandle.IsUnknown() {
+		tradeReq.TechHandle = plan.TechHandle.ValueString()
+	}
+	if !plan.BillingHandle.IsNull() && !plan.BillingHandle.IsUnknown() {
+		tradeReq.BillingHandle = plan.BillingHandle.ValueString()
+	}
+
+	if _, err := domains.Trade(r.client, tradeReq); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("owner_handle"),
+			"Error Trading Domain",
+			fmt.Sprintf("Could not trade domain %s to owner %s: %s", plan.Domain.ValueString(), tradeReq.OwnerHandle, err.Error()),
+		)
+		return false
+	}
+
+	return true
+}
+
+// Delete handles destroying the domain according to its deletion_policy. By default
+// deletion is refused as a safety measure.
+func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DomainModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := state.Domain.ValueString()
+
+	switch state.DeletionPolicy.ValueString() {
+	case deletionPolicyAbandon:
+		resp.Diagnostics.AddWarning(
+			"Domain Removed from Terraform State Only",
+			fmt.Sprintf("Domain %s has been removed from your Terraform state but NOT deleted in OpenProvider. "+
+				"The domain stays registered, keeps its current autorenew setting and continues to be billed. "+
+				"It can be reimported at any time.", domainName),
+		)
+		return
+	case deletionPolicyDelete:
+		// Handled below
+	default:
+		resp.Diagnostics.AddError(
+			"Domain Deletion Not Allowed",
+			"Domains cannot be deleted through this provider as a safety measure. Domain deletions are irreversible. "+
+				"Set deletion_policy = \"abandon\" to remove the domain from Terraform state only, or deletion_policy = \"delete\" "+
+				"to delete it at the registry.",
+		)
+		return
+	}
+
+	domain, err := getDomainByName(r.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Finding Domain",
+			fmt.Sprintf("Could not find domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	if domain == nil {
+		// Domain already gone
+		return
+	}
+
+	if !state.ForceDelete.ValueBool() {
+		created, err := parseDomainDate(domain.CreationDate)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Determine Add-Grace Period",
+				fmt.Sprintf("Could not determine when domain %s was registered (%s), so it is not known whether deleting it is refunded. "+
+					"Set force_delete = true to delete it anyway.", domainName, err.Error()),
+			)
+			return
+		}
+
+		if time.Since(created) > addGracePeriod {
+			resp.Diagnostics.AddError(
+				"Domain Outside Add-Grace Period",
+				fmt.Sprintf("Domain %s was registered on %s, which is outside the %d-day add-grace period. "+
+					"Deleting it now does not refund the registration fee, and the domain is released at the registry where others may register it. "+
+					"Set force_delete = true to delete it anyway, or use deletion_policy = \"abandon\".",
+					domainName, created.Format(time.DateOnly), int(addGracePeriod.Hours()/24)),
+			)
+			return
+		}
+	}
+
+	if err := domains.Delete(r.client, domain.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Domain",
+			fmt.Sprintf("Could not delete domain %s: %s", domainName, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Domain Deleted",
+		fmt.Sprintf("Domain %s has been deleted at the registry. Depending on the registry it may enter a redemption or quarantine period "+
+			"before it becomes available for registration again.", domainName),
 	)
 }
 
@@ -645,10 +1718,27 @@ func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportSta
 	)
 }
 
+// isInRedemption reports whether a domain status indicates the domain is in the
+// redemption period and can still be restored.
+func isInRedemption(status string) bool {
+	return status == domains.StatusExpired || status == domains.StatusDeleted
+}
+
+// parseDomainDate parses a date as returned by the Openprovider API.
+func parseDomainDate(value string) (time.Time, error) {
+	for _, layout := range []string{time.DateTime, time.RFC3339, time.DateOnly} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+}
+
 // getDomainByName finds a domain by its name using the List API.
 // Returns nil if the domain is not found.
 func getDomainByName(c *client.Client, domainName string) (*domains.Domain, error) {
-	domainList, err := domains.List(c)
+	name, extension, _ := splitDomainName(domainName)
+	domainList, err := domains.List(c, &domains.ListDomainsOptions{NamePattern: name, Extension: extension})
 	if err != nil {
 		return nil, err
 	}
@@ -663,3 +1753,203 @@ func getDomainByName(c *client.Client, domainName string) (*domains.Domain, erro
 
 	return nil, nil
 }
+
+// validateTLDCapabilities checks a planned domain against the capabilities of its
+// TLD. Period, transfer and additional data requirements only apply on create.
+func validateTLDCapabilities(plan DomainModel, isCreate bool, tld *tlds.TLD, diags *diag.Diagnostics) {
+	extension := tld.Name
+	isTransfer := !plan.AuthCode.IsNull() && !plan.AuthCode.IsUnknown() && plan.AuthCode.ValueString() != ""
+
+	if isCreate && isTransfer && !tld.TransferAvailable {
+		diags.AddAttributeError(
+			path.Root("auth_code"),
+			"Transfer Not Supported",
+			fmt.Sprintf("Domains under .%s cannot be transferred to OpenProvider.", extension),
+		)
+	}
+
+	if isCreate && !isTransfer && !plan.Period.IsNull() && !plan.Period.IsUnknown() {
+		period := plan.Period.ValueInt64()
+		if period < int64(tld.MinPeriod) || (tld.MaxPeriod > 0 && period > int64(tld.MaxPeriod)) {
+			diags.AddAttributeError(
+				path.Root("period"),
+				"Invalid Registration Period",
+				fmt.Sprintf("The .%s registry accepts registration periods from %d to %d years, got: %d", extension, tld.MinPeriod, tld.MaxPeriod, period),
+			)
+		}
+	}
+
+	if isCreate && !isTransfer && !plan.AdditionalData.IsUnknown() {
+		attributes := plan.AdditionalData.Attributes()
+		for _, name := range tld.RequiredAdditionalData {
+			if _, known := additionalDataAttrTypes[name]; !known {
+				continue
+			}
+			if value, ok := attributes[name]; !ok || value.IsNull() {
+				diags.AddAttributeError(
+					path.Root("additional_data").AtName(name),
+					"Missing Additional Data",
+					fmt.Sprintf("The .%s registry requires additional_data.%s to register a domain.", extension, name),
+				)
+			}
+		}
+	}
+
+	if plan.WhoisPrivacy.ValueBool() && !tld.IsPrivateWhoisAllowed {
+		diags.AddAttributeError(
+			path.Root("whois_privacy"),
+			"WHOIS Privacy Not Allowed",
+			fmt.Sprintf("The .%s registry does not permit WHOIS privacy services. Remove whois_privacy or set it to false.", extension),
+		)
+	}
+
+	if plan.IsLocked.ValueBool() && !tld.IsLockable {
+		diags.AddAttributeError(
+			path.Root("is_locked"),
+			"Registrar Lock Not Supported",
+			fmt.Sprintf("The .%s registry does not support registrar locking. Remove is_locked from the configuration.", extension),
+		)
+	}
+
+	hasDnssecKeys := !plan.DnssecKeys.IsNull() && !plan.DnssecKeys.IsUnknown() && len(plan.DnssecKeys.Elements()) > 0
+	if (plan.IsDnssecEnabled.ValueBool() || hasDnssecKeys || plan.ManagedDnssec.ValueBool()) && !tld.DnssecAllowed {
+		diags.AddAttributeError(
+			path.Root("is_dnssec_enabled"),
+			"DNSSEC Not Supported",
+			fmt.Sprintf("The .%s registry does not accept DNSSEC keys. Remove dnssec_keys, is_dnssec_enabled and managed_dnssec from the configuration.", extension),
+		)
+	}
+}
+
+// splitDomainName splits a domain name into the registered name and its extension,
+// as used by the domains API. Extensions may span several labels (e.g., co.uk),
+// while the registered name is a single label, so the name ends at the first dot.
+// ok is false when the domain name has no extension.
+func splitDomainName(domainName string) (name, extension string, ok bool) {
+	name, extension, ok = strings.Cut(domainName, ".")
+	return name, extension, ok && name != "" && extension != ""
+}
+
+// domainExtension returns the lower-cased extension of a domain name without the leading dot.
+func domainExtension(domainName string) string {
+	_, extension, _ := splitDomainName(domainName)
+	return strings.ToLower(extension)
+}
+
+// mapOwnerVerificationStatus reads the owner email verification of a domain for
+// owner_verification_status and warns when the domain is suspended because of it.
+// A verified owner stays verified, so the lookup is skipped unless the owner
+// changed. The current value is kept if the verification cannot be read.
+func (r *DomainResource) mapOwnerVerificationStatus(domainName string, ownerChanged bool, current types.String, diags *diag.Diagnostics) types.String {
+	if !ownerChanged && current.ValueString() == "verified" {
+		return current
+	}
+
+	verification, err := customers.GetDomainEmailVerification(r.client, domainName)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Read Owner Verification Status",
+			fmt.Sprintf("Could not read the owner email verification of domain %s: %s", domainName, err.Error()),
+		)
+		if current.IsUnknown() {
+			return types.StringNull()
+		}
+		return current
+	}
+
+	if verification != nil && verification.IsSuspended {
+		diags.AddWarning(
+			"Domain Suspended Pending Owner Verification",
+			fmt.Sprintf("Domain %s is suspended because the owner has not verified %s. Resend the verification email with the "+
+				"openprovider_domain_owner_verification_resend action.", domainName, verification.Email),
+		)
+	}
+
+	return types.StringValue(ownerVerificationStatus(verification))
+}
+
+// ownerVerificationStatus maps an owner email verification to the state exposed in owner_verification_status.
+func ownerVerificationStatus(verification *customers.EmailVerification) string {
+	if verification == nil {
+		return "none"
+	}
+	return strings.ReplaceAll(strings.ToLower(verification.Status), " ", "_")
+}
+
+// whoisPrivacyStatus maps the WHOIS privacy fields of a domain to the state exposed in whois_privacy_status.
+func whoisPrivacyStatus(domain *domains.Domain) string {
+	switch {
+	case domain.IsPrivateWhoisEnabled:
+		return "enabled"
+	case !domain.IsPrivateWhoisAllowed:
+		return "not_allowed"
+	default:
+		return "disabled"
+	}
+}
+
+// transferStatus maps a domain status to the transfer lifecycle state exposed in transfer_status.
+// current is the transfer_status in state. A completed transfer stays completed, as
+// later statuses such as EXP or DEL describe the domain rather than the transfer.
+func transferStatus(status, current string) string {
+	if current == "completed" {
+		return current
+	}
+
+	switch status {
+	case domains.StatusRequested, domains.StatusPending:
+		return "pending"
+	case domains.StatusFailed, domains.StatusRejected:
+		return "failed"
+	default:
+		return "completed"
+	}
+}
+
+// mapTransferToState returns the transfer_status and transfer_approver_email values for a domain.
+// Both are null for registered domains.
+func mapTransferToState(isTransfer bool, domain *domains.Domain, current types.String) (types.String, types.String) {
+	if !isTransfer {
+		return types.StringNull(), types.StringNull()
+	}
+
+	approverEmail := types.StringNull()
+	if domain.ApproverEmail != "" {
+		approverEmail = types.StringValue(domain.ApproverEmail)
+	}
+
+	return types.StringValue(transferStatus(domain.Status, current.ValueString())), approverEmail
+}
+
+// waitForTransfer polls a transferred domain until it becomes active or the transfer fails.
+// When the context expires first, the last seen domain is returned together with the context error.
+func waitForTransfer(ctx context.Context, c *client.Client, id int) (*domains.Domain, error) {
+	ticker := time.NewTicker(transferPollInterval)
+	defer ticker.Stop()
+
+	var domain *domains.Domain
+	for {
+		current, err := domains.Get(c, id)
+		if err != nil {
+			return domain, err
+		}
+		domain = current
+
+		switch transferStatus(domain.Status, "") {
+		case "completed":
+			return domain, nil
+		case "failed":
+			reason := domain.StatusDescription
+			if reason == "" {
+				reason = "no reason reported by the registry"
+			}
+			return domain, fmt.Errorf("transfer ended with status %s: %s", domain.Status, reason)
+		}
+
+		select {
+		case <-ctx.Done():
+			return domain, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
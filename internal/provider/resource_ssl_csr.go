@@ -0,0 +1,272 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &SSLCSRResource{}
+	_ resource.ResourceWithValidateConfig = &SSLCSRResource{}
+)
+
+// SSLCSRResource generates a private key and certificate signing request locally.
+type SSLCSRResource struct{}
+
+// SSLCSRModel describes the resource data model.
+type SSLCSRModel struct {
+	ID                 types.String `tfsdk:"id"`
+	CommonName         types.String `tfsdk:"common_name"`
+	AdditionalDomains  types.List   `tfsdk:"additional_domains"`
+	KeyAlgorithm       types.String `tfsdk:"key_algorithm"`
+	RSABits            types.Int64  `tfsdk:"rsa_bits"`
+	ECDSACurve         types.String `tfsdk:"ecdsa_curve"`
+	Organization       types.String `tfsdk:"organization"`
+	OrganizationalUnit types.String `tfsdk:"organizational_unit"`
+	Locality           types.String `tfsdk:"locality"`
+	Province           types.String `tfsdk:"province"`
+	Country            types.String `tfsdk:"country"`
+	PrivateKeyPEM      types.String `tfsdk:"private_key_pem"`
+	CSRPEM             types.String `tfsdk:"csr_pem"`
+}
+
+// NewSSLCSRResource returns a new instance of the SSL CSR resource.
+func NewSSLCSRResource() resource.Resource {
+	return &SSLCSRResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *SSLCSRResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssl_csr"
+}
+
+// Schema defines the schema for the resource.
+func (r *SSLCSRResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replaceString := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates a private key and a certificate signing request (CSR) for use with `openprovider_ssl_order`. " +
+			"Everything is generated locally by the provider; the private key is never sent to OpenProvider, but it is stored in the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 fingerprint of the CSR.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"common_name": schema.StringAttribute{
+				MarkdownDescription: "The common name (CN) of the certificate. It is also included in the SANs.",
+				Required:            true,
+				PlanModifiers:       replaceString,
+			},
+			"additional_domains": schema.ListAttribute{
+				MarkdownDescription: "Additional domains to include in the SANs.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_algorithm": schema.StringAttribute{
+				MarkdownDescription: "The algorithm of the generated key: `RSA` or `ECDSA`. Defaults to `RSA`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(keyAlgorithmRSA),
+				PlanModifiers:       replaceString,
+			},
+			"rsa_bits": schema.Int64Attribute{
+				MarkdownDescription: "The size of a generated RSA key: `2048`, `3072` or `4096`. Defaults to `2048`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(2048),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"ecdsa_curve": schema.StringAttribute{
+				MarkdownDescription: "The curve of a generated ECDSA key: `P256` or `P384`. Defaults to `P256`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("P256"),
+				PlanModifiers:       replaceString,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization (O) of the subject.",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"organizational_unit": schema.StringAttribute{
+				MarkdownDescription: "The organizational unit (OU) of the subject.",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"locality": schema.StringAttribute{
+				MarkdownDescription: "The locality (L) of the subject.",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"province": schema.StringAttribute{
+				MarkdownDescription: "The state or province (ST) of the subject.",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"country": schema.StringAttribute{
+				MarkdownDescription: "The two-letter country code (C) of the subject.",
+				Optional:            true,
+				PlanModifiers:       replaceString,
+			},
+			"private_key_pem": schema.StringAttribute{
+				MarkdownDescription: "The generated private key in PKCS#8 PEM format.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"csr_pem": schema.StringAttribute{
+				MarkdownDescription: "The generated certificate signing request in PEM format. Use it as `csr` of `openprovider_ssl_order`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the key parameters and the country code.
+func (r *SSLCSRResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SSLCSRModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.KeyAlgorithm.IsNull() && !config.KeyAlgorithm.IsUnknown() {
+		switch algorithm := config.KeyAlgorithm.ValueString(); algorithm {
+		case keyAlgorithmRSA, keyAlgorithmECDSA:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("key_algorithm"),
+				"Invalid Key Algorithm",
+				fmt.Sprintf("key_algorithm must be %q or %q, got: %q", keyAlgorithmRSA, keyAlgorithmECDSA, algorithm),
+			)
+		}
+	}
+
+	if !config.RSABits.IsNull() && !config.RSABits.IsUnknown() {
+		switch bits := config.RSABits.ValueInt64(); bits {
+		case 2048, 3072, 4096:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rsa_bits"),
+				"Invalid RSA Key Size",
+				fmt.Sprintf("rsa_bits must be 2048, 3072 or 4096, got: %d", bits),
+			)
+		}
+	}
+
+	if !config.ECDSACurve.IsNull() && !config.ECDSACurve.IsUnknown() {
+		switch curve := config.ECDSACurve.ValueString(); curve {
+		case "P256", "P384":
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ecdsa_curve"),
+				"Invalid ECDSA Curve",
+				fmt.Sprintf("ecdsa_curve must be \"P256\" or \"P384\", got: %q", curve),
+			)
+		}
+	}
+
+	if !config.Country.IsNull() && !config.Country.IsUnknown() && len(config.Country.ValueString()) != 2 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("country"),
+			"Invalid Country Code",
+			fmt.Sprintf("country must be a two-letter country code, got: %q", config.Country.ValueString()),
+		)
+	}
+}
+
+// Create generates the private key and CSR.
+func (r *SSLCSRResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SSLCSRModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var additionalDomains []string
+	if !plan.AdditionalDomains.IsNull() {
+		resp.Diagnostics.Append(plan.AdditionalDomains.ElementsAs(ctx, &additionalDomains, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	keyPEM, csrPEM, err := generateCSR(csrOptions{
+		KeyAlgorithm:       plan.KeyAlgorithm.ValueString(),
+		RSABits:            int(plan.RSABits.ValueInt64()),
+		ECDSACurve:         plan.ECDSACurve.ValueString(),
+		CommonName:         plan.CommonName.ValueString(),
+		AdditionalDomains:  additionalDomains,
+		Organization:       plan.Organization.ValueString(),
+		OrganizationalUnit: plan.OrganizationalUnit.ValueString(),
+		Locality:           plan.Locality.ValueString(),
+		Province:           plan.Province.ValueString(),
+		Country:            plan.Country.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Generating CSR",
+			fmt.Sprintf("Could not generate a private key and CSR for %s: %s", plan.CommonName.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	fingerprint := sha256.Sum256([]byte(csrPEM))
+	plan.ID = types.StringValue(hex.EncodeToString(fingerprint[:]))
+	plan.PrivateKeyPEM = types.StringValue(keyPEM)
+	plan.CSRPEM = types.StringValue(csrPEM)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the state as is, as the key and CSR only exist locally.
+func (r *SSLCSRResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// Update stores the planned values. Every configurable attribute requires
+// replacement, so the key and CSR are never changed in place.
+func (r *SSLCSRResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SSLCSRModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the key and CSR from Terraform state.
+func (r *SSLCSRResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
@@ -3,21 +3,55 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/charpand/terraform-provider-openprovider/internal/client"
 	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &SSLOrderResource{}
-	_ resource.ResourceWithConfigure = &SSLOrderResource{}
+	_ resource.Resource                   = &SSLOrderResource{}
+	_ resource.ResourceWithConfigure      = &SSLOrderResource{}
+	_ resource.ResourceWithValidateConfig = &SSLOrderResource{}
+	_ resource.ResourceWithModifyPlan     = &SSLOrderResource{}
+)
+
+// defaultIssuanceTimeout is how long Create waits for the certificate when
+// wait_for_issuance is enabled and no create timeout is configured.
+const defaultIssuanceTimeout = 60 * time.Minute
+
+// issuancePollInterval is the initial delay between status checks while waiting for
+// issuance. The delay doubles after every check, up to issuanceMaxPollInterval.
+var (
+	issuancePollInterval    = 10 * time.Second
+	issuanceMaxPollInterval = 2 * time.Minute
+)
+
+// Deletion policies supported by the deletion_policy attribute of SSL orders, in
+// addition to deletionPolicyAbandon.
+const (
+	// deletionPolicyCancel cancels the order at OpenProvider.
+	deletionPolicyCancel = "cancel"
+	// deletionPolicyCancelIfRefundable cancels the order only within the free refund
+	// period of the product, and abandons it otherwise.
+	deletionPolicyCancelIfRefundable = "cancel_if_refundable"
 )
 
 // SSLOrderResource is the resource implementation.
@@ -27,21 +61,39 @@ type SSLOrderResource struct {
 
 // SSLOrderModel describes the resource data model.
 type SSLOrderModel struct {
-	ID                     types.Int64  `tfsdk:"id"`
-	ProductID              types.Int64  `tfsdk:"product_id"`
-	CommonName             types.String `tfsdk:"common_name"`
-	BrandName              types.String `tfsdk:"brand_name"`
-	Status                 types.String `tfsdk:"status"`
-	OrderDate              types.String `tfsdk:"order_date"`
-	ActiveDate             types.String `tfsdk:"active_date"`
-	ExpirationDate         types.String `tfsdk:"expiration_date"`
-	Autorenew              types.Bool   `tfsdk:"autorenew"`
-	OwnerHandle            types.String `tfsdk:"owner_handle"`
-	AdminHandle            types.String `tfsdk:"admin_handle"`
-	BillingHandle          types.String `tfsdk:"billing_handle"`
-	TechnicalHandle        types.String `tfsdk:"technical_handle"`
-	AdditionalDomains      types.List   `tfsdk:"additional_domains"`
-	DomainValidationMethod types.String `tfsdk:"domain_validation_method"`
+	ID                     types.Int64    `tfsdk:"id"`
+	ProductID              types.Int64    `tfsdk:"product_id"`
+	CommonName             types.String   `tfsdk:"common_name"`
+	BrandName              types.String   `tfsdk:"brand_name"`
+	Status                 types.String   `tfsdk:"status"`
+	OrderDate              types.String   `tfsdk:"order_date"`
+	ActiveDate             types.String   `tfsdk:"active_date"`
+	ExpirationDate         types.String   `tfsdk:"expiration_date"`
+	Autorenew              types.Bool     `tfsdk:"autorenew"`
+	OwnerHandle            types.String   `tfsdk:"owner_handle"`
+	AdminHandle            types.String   `tfsdk:"admin_handle"`
+	BillingHandle          types.String   `tfsdk:"billing_handle"`
+	TechnicalHandle        types.String   `tfsdk:"technical_handle"`
+	AdditionalDomains      types.List     `tfsdk:"additional_domains"`
+	DomainValidationMethod types.String   `tfsdk:"domain_validation_method"`
+	CSR                    types.String   `tfsdk:"csr"`
+	ApproverEmail          types.String   `tfsdk:"approver_email"`
+	WaitForIssuance        types.Bool     `tfsdk:"wait_for_issuance"`
+	AutoDNSValidation      types.Bool     `tfsdk:"auto_dns_validation"`
+	DCVRecords             types.List     `tfsdk:"dcv_records"`
+	RenewBeforeDays        types.Int64    `tfsdk:"renew_before_days"`
+	RenewalPeriod          types.Int64    `tfsdk:"renewal_period"`
+	DeletionPolicy         types.String   `tfsdk:"deletion_policy"`
+	CertificatePEM         types.String   `tfsdk:"certificate_pem"`
+	CABundlePEM            types.String   `tfsdk:"ca_bundle_pem"`
+	FullChainPEM           types.String   `tfsdk:"full_chain_pem"`
+	SerialNumber           types.String   `tfsdk:"serial_number"`
+	NotBefore              types.String   `tfsdk:"not_before"`
+	NotAfter               types.String   `tfsdk:"not_after"`
+	FingerprintSHA256      types.String   `tfsdk:"fingerprint_sha256"`
+	Issuer                 types.String   `tfsdk:"issuer"`
+	SubjectAltNames        types.List     `tfsdk:"subject_alternative_names"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 // NewSSLOrderResource returns a new instance of the SSL order resource.
@@ -55,13 +107,16 @@ func (r *SSLOrderResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *SSLOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *SSLOrderResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an SSL/TLS certificate order.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "The SSL order identifier.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"product_id": schema.Int64Attribute{
 				MarkdownDescription: "The SSL product ID to order.",
@@ -118,17 +173,127 @@ func (r *SSLOrderResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:            true,
 			},
 			"additional_domains": schema.ListAttribute{
-				MarkdownDescription: "List of additional domains to include in the SSL certificate (SANs).",
+				MarkdownDescription: "List of additional domains to include in the SSL certificate (SANs). Changing it reissues the certificate within the free reissue period of the product.",
 				ElementType:         types.StringType,
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"domain_validation_method": schema.StringAttribute{
-				MarkdownDescription: "The method used to validate domain ownership (dns, http, email, etc.).",
+				MarkdownDescription: "The method used to validate domain ownership (dns, http, email, etc.). Changing it reissues the certificate within the free reissue period of the product.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("dns"),
 			},
+			"csr": schema.StringAttribute{
+				MarkdownDescription: "A PEM encoded certificate signing request, to control the key pair of the certificate. Its common name and SANs must match `common_name` and `additional_domains`. When unset, OpenProvider generates the key pair. Changing it reissues the certificate within the free reissue period of the product.",
+				Optional:            true,
+			},
+			"approver_email": schema.StringAttribute{
+				MarkdownDescription: "The address that approves the order when `domain_validation_method` is `email`. It must be one of the approver emails of `common_name` for the product, as listed by the `openprovider_ssl_approver_emails` data source, and is checked at plan time. Changing it reissues the certificate within the free reissue period of the product.",
+				Optional:            true,
+			},
+			"wait_for_issuance": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the certificate to be issued before finishing the apply. The order is polled until it is `ACT` or has failed, bounded by the `create` timeout (default 60m). Only used when the order is placed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"renew_before_days": schema.Int64Attribute{
+				MarkdownDescription: "Renew the certificate when it expires within this number of days. The renewal is planned as an update when a refresh finds the certificate inside the window. Disabled by default.",
+				Optional:            true,
+			},
+			"renewal_period": schema.Int64Attribute{
+				MarkdownDescription: "The period in years to renew the certificate for when `renew_before_days` triggers a renewal. Defaults to `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+			},
+			"deletion_policy": schema.StringAttribute{
+				MarkdownDescription: "What happens to the order when the resource is destroyed: `abandon` (default) removes it from Terraform state only, `cancel` cancels it at OpenProvider, `cancel_if_refundable` cancels it only within the free refund period of the product and abandons it otherwise.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(deletionPolicyAbandon),
+			},
+			"auto_dns_validation": schema.BoolAttribute{
+				MarkdownDescription: "Publish the DCV records of the order in the OpenProvider DNS zones that host them, and remove them once the certificate has been issued or the order has failed. Requires `domain_validation_method = \"dns\"`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dcv_records": schema.ListNestedAttribute{
+				MarkdownDescription: "The DNS records the certificate authority checks to validate control of each domain. Create them yourself for zones that are not hosted at OpenProvider.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The domain of the order this record validates.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The record type (e.g., `CNAME` or `TXT`).",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The fully qualified record name.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The record value.",
+							Computed:            true,
+						},
+						"published": schema.BoolAttribute{
+							MarkdownDescription: "Whether the record is currently published in OpenProvider DNS by `auto_dns_validation`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"certificate_pem": schema.StringAttribute{
+				MarkdownDescription: "The issued certificate in PEM format. Empty until the certificate has been issued.",
+				Computed:            true,
+			},
+			"ca_bundle_pem": schema.StringAttribute{
+				MarkdownDescription: "The intermediate CA certificates in PEM format.",
+				Computed:            true,
+			},
+			"full_chain_pem": schema.StringAttribute{
+				MarkdownDescription: "The issued certificate followed by the intermediate CA certificates, in PEM format.",
+				Computed:            true,
+			},
+			"serial_number": schema.StringAttribute{
+				MarkdownDescription: "The serial number of the issued certificate, in hexadecimal.",
+				Computed:            true,
+			},
+			"not_before": schema.StringAttribute{
+				MarkdownDescription: "The start of the validity period of the issued certificate, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"not_after": schema.StringAttribute{
+				MarkdownDescription: "The end of the validity period of the issued certificate, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"fingerprint_sha256": schema.StringAttribute{
+				MarkdownDescription: "The SHA-256 fingerprint of the issued certificate, in hexadecimal.",
+				Computed:            true,
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "The distinguished name of the issuer of the certificate.",
+				Computed:            true,
+			},
+			"subject_alternative_names": schema.ListAttribute{
+				MarkdownDescription: "The DNS names and IP addresses the issued certificate is valid for.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
 		},
 	}
 }
@@ -139,16 +304,314 @@ func (r *SSLOrderResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	data, ok := req.ProviderData.(*ResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+}
+
+// ValidateConfig checks that a configured CSR matches the common name and additional domains.
+func (r *SSLOrderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SSLOrderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.AutoDNSValidation.ValueBool() && !config.DomainValidationMethod.IsNull() &&
+		!config.DomainValidationMethod.IsUnknown() && config.DomainValidationMethod.ValueString() != "dns" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("auto_dns_validation"),
+			"Invalid Domain Validation Method",
+			fmt.Sprintf("auto_dns_validation requires domain_validation_method \"dns\", got: %q", config.DomainValidationMethod.ValueString()),
+		)
+	}
+
+	if !config.ApproverEmail.IsNull() && !config.DomainValidationMethod.IsUnknown() {
+		// domain_validation_method defaults to "dns" when unset
+		method := config.DomainValidationMethod.ValueString()
+		if config.DomainValidationMethod.IsNull() {
+			method = "dns"
+		}
+		if method != "email" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("approver_email"),
+				"Invalid Domain Validation Method",
+				fmt.Sprintf("approver_email requires domain_validation_method \"email\", got: %q", method),
+			)
+		}
+	}
+
+	if !config.DeletionPolicy.IsNull() && !config.DeletionPolicy.IsUnknown() {
+		switch policy := config.DeletionPolicy.ValueString(); policy {
+		case deletionPolicyAbandon, deletionPolicyCancel, deletionPolicyCancelIfRefundable:
+		default:
+			resp.Diagnostics.AddAttributeError(
+				path.Root("deletion_policy"),
+				"Invalid Deletion Policy",
+				fmt.Sprintf("deletion_policy must be one of %q, %q or %q, got: %q",
+					deletionPolicyAbandon, deletionPolicyCancel, deletionPolicyCancelIfRefundable, policy),
+			)
+		}
+	}
+
+	if !config.RenewBeforeDays.IsNull() && !config.RenewBeforeDays.IsUnknown() && config.RenewBeforeDays.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("renew_before_days"),
+			"Invalid Renewal Window",
+			fmt.Sprintf("renew_before_days must be at least 1, got: %d", config.RenewBeforeDays.ValueInt64()),
+		)
+	}
+	if !config.RenewalPeriod.IsNull() && !config.RenewalPeriod.IsUnknown() && config.RenewalPeriod.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("renewal_period"),
+			"Invalid Renewal Period",
+			fmt.Sprintf("renewal_period must be at least 1, got: %d", config.RenewalPeriod.ValueInt64()),
 		)
+	}
+
+	// The CSR is checked again on create when it is only known after apply
+	if config.CSR.IsNull() || config.CSR.IsUnknown() || config.CommonName.IsUnknown() || config.AdditionalDomains.IsUnknown() {
 		return
 	}
 
-	r.client = client
+	var additionalDomains []string
+	resp.Diagnostics.Append(config.AdditionalDomains.ElementsAs(ctx, &additionalDomains, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateCSR(config.CSR.ValueString(), config.CommonName.ValueString(), additionalDomains); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("csr"),
+			"Invalid CSR",
+			fmt.Sprintf("The certificate signing request cannot be used for this order: %s", err.Error()),
+		)
+	}
+}
+
+// ModifyPlan checks approver_email against the approver emails of the common name,
+// plans a renewal for certificates that expire within renew_before_days, plans an
+// update for DCV records that still have to be published or removed, and checks
+// that changes which reissue the certificate are within the free reissue period of
+// the product.
+func (r *SSLOrderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state SSLOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	creating := req.State.Raw.IsNull()
+	if !creating {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if creating || !plan.ApproverEmail.Equal(state.ApproverEmail) {
+		r.checkApproverEmail(plan, &resp.Diagnostics)
+	}
+	if creating {
+		return
+	}
+
+	if renewalDue(state, plan.RenewBeforeDays, renewalRequestedFor(ctx, req.Private, &resp.Diagnostics), time.Now()) {
+		planRenewal(ctx, state, plan, resp)
+	}
+
+	// Publish or remove DCV records on apply when they no longer match the order
+	dcvState := state
+	dcvState.AutoDNSValidation = plan.AutoDNSValidation
+	if dcvSyncRequired(ctx, dcvState, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dcv_records"), types.ListUnknown(types.ObjectType{AttrTypes: dcvRecordAttrTypes}))...)
+	}
+
+	if !reissueRequired(plan, state) || r.client == nil {
+		return
+	}
+
+	product, err := ssl.GetProduct(r.client, int(state.ProductID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check Reissue Period",
+			fmt.Sprintf("Could not read SSL product %d to check its free reissue period: %s", state.ProductID.ValueInt64(), err.Error()),
+		)
+		return
+	}
+
+	checkReissuePeriod(state, product.FreeReissueDays, time.Now(), &resp.Diagnostics)
+}
+
+// checkApproverEmail adds an error when approver_email is not one of the approver
+// emails OpenProvider allows for the common name and product.
+func (r *SSLOrderResource) checkApproverEmail(plan SSLOrderModel, diags *diag.Diagnostics) {
+	if r.client == nil || plan.ApproverEmail.IsNull() || plan.ApproverEmail.IsUnknown() ||
+		plan.CommonName.IsUnknown() || plan.ProductID.IsUnknown() {
+		return
+	}
+
+	approverEmail := plan.ApproverEmail.ValueString()
+	commonName := plan.CommonName.ValueString()
+	emails, err := ssl.ListApproverEmails(r.client, commonName, int(plan.ProductID.ValueInt64()))
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Check Approver Email",
+			fmt.Sprintf("Could not list the approver emails of %s to check approver_email: %s", commonName, err.Error()),
+		)
+		return
+	}
+
+	for _, email := range emails {
+		if strings.EqualFold(email, approverEmail) {
+			return
+		}
+	}
+
+	allowed := "No approver emails are available for this domain and product."
+	if len(emails) > 0 {
+		allowed = fmt.Sprintf("Allowed approver emails: %s.", strings.Join(emails, ", "))
+	}
+	diags.AddAttributeError(
+		path.Root("approver_email"),
+		"Invalid Approver Email",
+		fmt.Sprintf("%s cannot approve the certificate for %s. %s", approverEmail, commonName, allowed),
+	)
+}
+
+// renewalRequestedKey is the private state key that holds the expiration date of
+// the certificate a renewal was last requested for.
+const renewalRequestedKey = "renewal_requested_for"
+
+// privateState reads provider-defined private state of a resource.
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// renewalRequestedFor returns the expiration date of the certificate a renewal was
+// last requested for, or an empty string when none was requested.
+func renewalRequestedFor(ctx context.Context, private privateState, diags *diag.Diagnostics) string {
+	value, getDiags := private.GetKey(ctx, renewalRequestedKey)
+	diags.Append(getDiags...)
+
+	var expirationDate string
+	if len(value) > 0 {
+		if err := json.Unmarshal(value, &expirationDate); err != nil {
+			return ""
+		}
+	}
+	return expirationDate
+}
+
+// certificateExpiration returns the expiration date of the issued certificate of
+// an order.
+func certificateExpiration(state SSLOrderModel) string {
+	if expirationDate := state.ExpirationDate.ValueString(); expirationDate != "" {
+		return expirationDate
+	}
+	return state.NotAfter.ValueString()
+}
+
+// renewalDue reports whether the issued certificate of an order expires within
+// renewBeforeDays. Orders that are not active, for example because a renewal is
+// still being processed, are never due. Neither are certificates a renewal was
+// already requested for: the order stays active with the old expiration date until
+// the renewed certificate is issued.
+func renewalDue(state SSLOrderModel, renewBeforeDays types.Int64, requestedFor string, now time.Time) bool {
+	if renewBeforeDays.IsNull() || renewBeforeDays.IsUnknown() || state.Status.ValueString() != ssl.StatusActive {
+		return false
+	}
+
+	expirationDate := certificateExpiration(state)
+	if expirationDate == requestedFor {
+		return false
+	}
+	expires, err := parseDomainDate(expirationDate)
+	if err != nil {
+		return false
+	}
+	return now.AddDate(0, 0, int(renewBeforeDays.ValueInt64())).After(expires)
+}
+
+// renewedAttributes are the computed attributes that change when a certificate is
+// renewed.
+var renewedAttributes = []string{
+	"status", "active_date", "expiration_date", "certificate_pem", "ca_bundle_pem", "full_chain_pem",
+	"serial_number", "not_before", "not_after", "fingerprint_sha256", "issuer",
+}
+
+// planRenewal marks the certificate attributes as changing so the renewal is
+// applied in Update, and surfaces it as a plan warning.
+func planRenewal(ctx context.Context, state, plan SSLOrderModel, resp *resource.ModifyPlanResponse) {
+	for _, name := range renewedAttributes {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("subject_alternative_names"), types.ListUnknown(types.StringType))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dcv_records"), types.ListUnknown(types.ObjectType{AttrTypes: dcvRecordAttrTypes}))...)
+
+	resp.Diagnostics.AddWarning(
+		"SSL Renewal Planned",
+		fmt.Sprintf("The certificate of SSL order %d for %s expires on %s, within renew_before_days (%d). "+
+			"It will be renewed for %d year(s) on apply.",
+			state.ID.ValueInt64(), state.CommonName.ValueString(), state.ExpirationDate.ValueString(),
+			plan.RenewBeforeDays.ValueInt64(), plan.RenewalPeriod.ValueInt64()),
+	)
+}
+
+// reissueRequired reports whether the plan changes attributes that are applied by
+// reissuing the certificate.
+func reissueRequired(plan, state SSLOrderModel) bool {
+	return !plan.AdditionalDomains.Equal(state.AdditionalDomains) ||
+		!plan.CSR.Equal(state.CSR) ||
+		!plan.DomainValidationMethod.Equal(state.DomainValidationMethod) ||
+		!plan.ApproverEmail.Equal(state.ApproverEmail)
+}
+
+// checkReissuePeriod adds an error when the free reissue period of an order has
+// ended. The period starts when the certificate is issued, or when the order was
+// placed for orders that are still pending.
+func checkReissuePeriod(state SSLOrderModel, freeReissueDays int, now time.Time, diags *diag.Diagnostics) {
+	orderID := state.ID.ValueInt64()
+	commonName := state.CommonName.ValueString()
+	replaceHint := "Changing additional_domains, csr, domain_validation_method or approver_email now requires a new order; " +
+		"replace the resource with terraform apply -replace to place one."
+
+	if freeReissueDays <= 0 {
+		diags.AddError(
+			"SSL Reissue Not Available",
+			fmt.Sprintf("The product of SSL order %d for %s does not include free reissues. %s", orderID, commonName, replaceHint),
+		)
+		return
+	}
+
+	startDate := state.ActiveDate.ValueString()
+	if startDate == "" {
+		startDate = state.OrderDate.ValueString()
+	}
+	start, err := parseDomainDate(startDate)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Check Reissue Period",
+			fmt.Sprintf("Could not determine when SSL order %d for %s was issued: %s", orderID, commonName, err.Error()),
+		)
+		return
+	}
+
+	end := start.AddDate(0, 0, freeReissueDays)
+	if now.After(end) {
+		diags.AddError(
+			"SSL Reissue Period Ended",
+			fmt.Sprintf("SSL order %d for %s could be reissued for free until %s (%d days). %s",
+				orderID, commonName, end.Format(time.DateOnly), freeReissueDays, replaceHint),
+		)
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -174,6 +637,7 @@ func (r *SSLOrderResource) Create(ctx context.Context, req resource.CreateReques
 		CommonName:             plan.CommonName.ValueString(),
 		AdditionalDomains:      additionalDomains,
 		DomainValidationMethod: plan.DomainValidationMethod.ValueString(),
+		ApproverEmail:          plan.ApproverEmail.ValueString(),
 	}
 
 	if !plan.OwnerHandle.IsNull() {
@@ -194,6 +658,18 @@ func (r *SSLOrderResource) Create(ctx context.Context, req resource.CreateReques
 		createReq.Autorenew = "off"
 	}
 
+	if !plan.CSR.IsNull() {
+		if err := validateCSR(plan.CSR.ValueString(), plan.CommonName.ValueString(), additionalDomains); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("csr"),
+				"Invalid CSR",
+				fmt.Sprintf("The certificate signing request cannot be used for this order: %s", err.Error()),
+			)
+			return
+		}
+		createReq.CSR = plan.CSR.ValueString()
+	}
+
 	order, err := ssl.CreateOrder(r.client, createReq)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -203,27 +679,58 @@ func (r *SSLOrderResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Map response to state
-	plan.ID = types.Int64Value(int64(order.ID))
-	plan.BrandName = types.StringValue(order.BrandName)
-	plan.Status = types.StringValue(order.Status)
-	plan.OrderDate = types.StringValue(order.OrderDate)
-	plan.ActiveDate = types.StringValue(order.ActiveDate)
-	plan.ExpirationDate = types.StringValue(order.ExpirationDate)
-	plan.Autorenew = types.BoolValue(order.Autorenew == "on")
-	plan.OwnerHandle = types.StringValue(order.OwnerHandle)
-	plan.AdminHandle = types.StringValue(order.AdminHandle)
-	plan.BillingHandle = types.StringValue(order.BillingHandle)
-	plan.TechnicalHandle = types.StringValue(order.TechnicalHandle)
+	if plan.AutoDNSValidation.ValueBool() {
+		// The create response does not include the DCV records
+		current, err := ssl.GetOrder(r.client, order.ID)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"DCV Records Not Published",
+				fmt.Sprintf("Could not read SSL order %d to publish its DCV records: %s. They are published by the next apply.", order.ID, err.Error()),
+			)
+		} else {
+			order = current
+		}
+	}
+	plan.DCVRecords = types.ListNull(types.ObjectType{AttrTypes: dcvRecordAttrTypes})
+	r.syncDCVRecords(ctx, order, &plan, &resp.Diagnostics)
 
-	if len(order.AdditionalDomains) > 0 {
-		domainsVal, diags := types.ListValueFrom(ctx, types.StringType, order.AdditionalDomains)
+	if plan.WaitForIssuance.ValueBool() {
+		createTimeout, diags := plan.Timeouts.Create(ctx, defaultIssuanceTimeout)
 		resp.Diagnostics.Append(diags...)
-		plan.AdditionalDomains = domainsVal
-	} else {
-		plan.AdditionalDomains = types.ListNull(types.StringType)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+		defer cancel()
+
+		issued, err := waitForIssuance(waitCtx, r.client, order.ID, "")
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			// The order has been placed. Keep it in state so the next apply does
+			// not order a second certificate.
+			resp.Diagnostics.AddWarning(
+				"SSL Certificate Not Yet Issued",
+				fmt.Sprintf("The certificate for %s was not issued within %s. The order remains open at OpenProvider; "+
+					"the certificate is read on the next refresh.", plan.CommonName.ValueString(), createTimeout),
+			)
+		case err != nil:
+			resp.Diagnostics.AddError(
+				"SSL Certificate Issuance Failed",
+				fmt.Sprintf("The certificate for %s was not issued: %s", plan.CommonName.ValueString(), err.Error()),
+			)
+			return
+		}
+		if issued != nil {
+			order = issued
+			r.syncDCVRecords(ctx, order, &plan, &resp.Diagnostics)
+		}
 	}
 
+	// Map response to state
+	plan.ID = types.Int64Value(int64(order.ID))
+	mapSSLOrderToState(ctx, order, &plan, &resp.Diagnostics)
+
 	// Set state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -250,68 +757,221 @@ func (r *SSLOrderResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Update state
-	state.BrandName = types.StringValue(order.BrandName)
-	state.Status = types.StringValue(order.Status)
-	state.OrderDate = types.StringValue(order.OrderDate)
-	state.ActiveDate = types.StringValue(order.ActiveDate)
-	state.ExpirationDate = types.StringValue(order.ExpirationDate)
-	state.Autorenew = types.BoolValue(order.Autorenew == "on")
-	state.OwnerHandle = types.StringValue(order.OwnerHandle)
-	state.AdminHandle = types.StringValue(order.AdminHandle)
-	state.BillingHandle = types.StringValue(order.BillingHandle)
-	state.TechnicalHandle = types.StringValue(order.TechnicalHandle)
+	mapSSLOrderToState(ctx, order, &state, &resp.Diagnostics)
+	r.refreshDCVRecords(ctx, order, &state, &resp.Diagnostics)
+
+	// Set state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// mapSSLOrderToState maps the computed attributes of an SSL order, including the
+// issued certificate, to the Terraform model.
+func mapSSLOrderToState(ctx context.Context, order *ssl.SSLOrder, model *SSLOrderModel, diags *diag.Diagnostics) {
+	model.BrandName = types.StringValue(order.BrandName)
+	model.Status = types.StringValue(order.Status)
+	model.OrderDate = types.StringValue(order.OrderDate)
+	model.ActiveDate = types.StringValue(order.ActiveDate)
+	model.ExpirationDate = types.StringValue(order.ExpirationDate)
+	model.Autorenew = types.BoolValue(order.Autorenew == "on")
+	model.OwnerHandle = types.StringValue(order.OwnerHandle)
+	model.AdminHandle = types.StringValue(order.AdminHandle)
+	model.BillingHandle = types.StringValue(order.BillingHandle)
+	model.TechnicalHandle = types.StringValue(order.TechnicalHandle)
 
 	if len(order.AdditionalDomains) > 0 {
-		domainsVal, diags := types.ListValueFrom(ctx, types.StringType, order.AdditionalDomains)
-		resp.Diagnostics.Append(diags...)
-		state.AdditionalDomains = domainsVal
+		domainsVal, listDiags := types.ListValueFrom(ctx, types.StringType, order.AdditionalDomains)
+		diags.Append(listDiags...)
+		model.AdditionalDomains = domainsVal
 	} else {
-		state.AdditionalDomains = types.ListNull(types.StringType)
+		model.AdditionalDomains = types.ListNull(types.StringType)
 	}
 
-	// Set state
-	diags = resp.State.Set(ctx, state)
-	resp.Diagnostics.Append(diags...)
+	mapCertificateToState(ctx, order, model, diags)
+}
+
+// issuanceFailureReason describes why an order in a final status other than active
+// did not result in a certificate. It returns an empty string for other statuses.
+func issuanceFailureReason(status string) string {
+	switch status {
+	case ssl.StatusFailed:
+		return "domain control validation failed"
+	case ssl.StatusRejected:
+		return "the order was rejected by the certificate authority"
+	case ssl.StatusCancelled:
+		return "the order was cancelled"
+	}
+	return ""
+}
+
+// waitForIssuance polls an SSL order with backoff until a certificate other than
+// previousCertificate is issued or the order fails. When the context expires first,
+// the last seen order is returned together with the context error.
+func waitForIssuance(ctx context.Context, c *client.Client, id int, previousCertificate string) (*ssl.SSLOrder, error) {
+	interval := issuancePollInterval
+
+	var order *ssl.SSLOrder
+	for {
+		current, err := ssl.GetOrder(c, id)
+		if err != nil {
+			return order, err
+		}
+		order = current
+
+		if order.Status == ssl.StatusActive && order.Certificate != "" && order.Certificate != previousCertificate {
+			return order, nil
+		}
+		if reason := issuanceFailureReason(order.Status); reason != "" {
+			return order, fmt.Errorf("order %d ended with status %s: %s", id, order.Status, reason)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return order, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval = min(interval*2, issuanceMaxPollInterval)
+	}
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *SSLOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan SSLOrderModel
+	var plan, state SSLOrderModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	orderID := int(plan.ID.ValueInt64())
+	orderID := int(state.ID.ValueInt64())
+	plan.ID = state.ID
 
-	updateReq := &ssl.UpdateSSLOrderRequest{}
-	if plan.Autorenew.ValueBool() {
-		updateReq.Autorenew = "on"
-	} else {
-		updateReq.Autorenew = "off"
+	if !plan.Autorenew.Equal(state.Autorenew) {
+		updateReq := &ssl.UpdateSSLOrderRequest{}
+		if plan.Autorenew.ValueBool() {
+			updateReq.Autorenew = "on"
+		} else {
+			updateReq.Autorenew = "off"
+		}
+
+		if _, err := ssl.UpdateOrder(r.client, orderID, updateReq); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating SSL order",
+				fmt.Sprintf("Could not update SSL order: %s", err.Error()),
+			)
+			return
+		}
 	}
 
-	order, err := ssl.UpdateOrder(r.client, orderID, updateReq)
+	if reissueRequired(plan, state) {
+		var additionalDomains []string
+		if !plan.AdditionalDomains.IsNull() {
+			resp.Diagnostics.Append(plan.AdditionalDomains.ElementsAs(ctx, &additionalDomains, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		reissueReq := &ssl.ReissueSSLOrderRequest{
+			CommonName:             plan.CommonName.ValueString(),
+			AdditionalDomains:      additionalDomains,
+			DomainValidationMethod: plan.DomainValidationMethod.ValueString(),
+			ApproverEmail:          plan.ApproverEmail.ValueString(),
+		}
+		if !plan.CSR.IsNull() {
+			if err := validateCSR(plan.CSR.ValueString(), plan.CommonName.ValueString(), additionalDomains); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("csr"),
+					"Invalid CSR",
+					fmt.Sprintf("The certificate signing request cannot be used for this order: %s", err.Error()),
+				)
+				return
+			}
+			reissueReq.CSR = plan.CSR.ValueString()
+		}
+
+		if _, err := ssl.ReissueOrder(r.client, orderID, reissueReq); err != nil {
+			resp.Diagnostics.AddError(
+				"Error reissuing SSL order",
+				fmt.Sprintf("Could not reissue SSL order %d: %s", orderID, err.Error()),
+			)
+			return
+		}
+	}
+
+	renewed := renewalDue(state, plan.RenewBeforeDays, renewalRequestedFor(ctx, req.Private, &resp.Diagnostics), time.Now())
+	if renewed {
+		renewReq := &ssl.RenewSSLOrderRequest{Period: int(plan.RenewalPeriod.ValueInt64())}
+		if _, err := ssl.RenewOrder(r.client, orderID, renewReq); err != nil {
+			resp.Diagnostics.AddError(
+				"Error renewing SSL order",
+				fmt.Sprintf("Could not renew SSL order %d: %s", orderID, err.Error()),
+			)
+			return
+		}
+
+		// Remember the renewal so it is not requested again while the order still
+		// reports the old expiration date
+		requestedFor, _ := json.Marshal(certificateExpiration(state))
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, renewalRequestedKey, requestedFor)...)
+	}
+
+	// The update response does not include the full order, so read it back
+	order, err := ssl.GetOrder(r.client, orderID)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error updating SSL order",
-			fmt.Sprintf("Could not update SSL order: %s", err.Error()),
+			"Error reading SSL order",
+			fmt.Sprintf("Could not read SSL order after update: %s", err.Error()),
 		)
 		return
 	}
 
+	if renewed && plan.WaitForIssuance.ValueBool() {
+		updateTimeout, diags := plan.Timeouts.Update(ctx, defaultIssuanceTimeout)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+		defer cancel()
+
+		issued, err := waitForIssuance(waitCtx, r.client, orderID, state.CertificatePEM.ValueString())
+		switch {
+		case errors.Is(err, context.DeadlineExceeded):
+			resp.Diagnostics.AddWarning(
+				"SSL Certificate Not Yet Renewed",
+				fmt.Sprintf("The renewed certificate for %s was not issued within %s. The renewal continues at OpenProvider; "+
+					"the certificate is read on the next refresh.", plan.CommonName.ValueString(), updateTimeout),
+			)
+		case err != nil:
+			// The renewal has been ordered, so the order is kept in state
+			resp.Diagnostics.AddError(
+				"SSL Certificate Renewal Failed",
+				fmt.Sprintf("The renewed certificate for %s was not issued: %s", plan.CommonName.ValueString(), err.Error()),
+			)
+		}
+		if issued != nil {
+			order = issued
+		}
+	}
+
 	// Update state
-	plan.Status = types.StringValue(order.Status)
-	plan.ExpirationDate = types.StringValue(order.ExpirationDate)
-	plan.Autorenew = types.BoolValue(order.Autorenew == "on")
+	mapSSLOrderToState(ctx, order, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("dcv_records"), &plan.DCVRecords)...)
+	r.syncDCVRecords(ctx, order, &plan, &resp.Diagnostics)
 
 	// Set state
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
-// Delete removes the resource from Terraform state without canceling the SSL order.
+// Delete handles destroying the order according to its deletion_policy. By default
+// the order is only removed from Terraform state.
 func (r *SSLOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state SSLOrderModel
 	diags := req.State.Get(ctx, &state)
@@ -323,14 +983,70 @@ func (r *SSLOrderResource) Delete(ctx context.Context, req resource.DeleteReques
 	orderID := int(state.ID.ValueInt64())
 	commonName := state.CommonName.ValueString()
 
-	// Remove from Terraform state only - do not cancel the SSL order in OpenProvider
-	// SSL orders are long-lived assets with certificate lifecycle implications
-	// Cancellation may incur costs or penalties and should be handled deliberately
-	resp.Diagnostics.AddWarning(
-		"SSL Order Removed from Terraform State Only",
-		fmt.Sprintf("SSL order %d for %s has been removed from your Terraform state but NOT canceled in OpenProvider. "+
-			"The SSL certificate order and active certificate still exist. "+
-			"To cancel or renew this SSL order, use the OpenProvider dashboard directly.",
-			orderID, commonName),
-	)
+	switch state.DeletionPolicy.ValueString() {
+	case deletionPolicyCancel:
+		// Handled below
+	case deletionPolicyCancelIfRefundable:
+		refundable, reason := r.orderRefundable(state, time.Now())
+		if reason != "" {
+			resp.Diagnostics.AddError(
+				"Unable to Determine Refund Period",
+				fmt.Sprintf("Could not determine whether canceling SSL order %d for %s is refunded: %s. "+
+					"Set deletion_policy = \"cancel\" to cancel it anyway, or \"abandon\" to keep it.", orderID, commonName, reason),
+			)
+			return
+		}
+		if !refundable {
+			resp.Diagnostics.AddWarning(
+				"SSL Order Not Refundable",
+				fmt.Sprintf("SSL order %d for %s is outside the free refund period of its product, so it has NOT been canceled. "+
+					"It has been removed from your Terraform state only and the certificate stays valid until it expires. "+
+					"Set deletion_policy = \"cancel\" to cancel orders without a refund.", orderID, commonName),
+			)
+			return
+		}
+	default:
+		// Remove from Terraform state only - do not cancel the SSL order in OpenProvider
+		// SSL orders are long-lived assets with certificate lifecycle implications
+		// Cancellation may incur costs or penalties and should be handled deliberately
+		resp.Diagnostics.AddWarning(
+			"SSL Order Removed from Terraform State Only",
+			fmt.Sprintf("SSL order %d for %s has been removed from your Terraform state but NOT canceled in OpenProvider. "+
+				"The SSL certificate order and active certificate still exist. "+
+				"Set deletion_policy to \"cancel\" or \"cancel_if_refundable\" to cancel orders on destroy.",
+				orderID, commonName),
+		)
+		return
+	}
+
+	if err := ssl.CancelOrder(r.client, orderID); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Canceling SSL Order",
+			fmt.Sprintf("Could not cancel SSL order %d for %s: %s", orderID, commonName, err.Error()),
+		)
+		return
+	}
+
+	// Remove DCV records that were published for the canceled order. Orders that are
+	// kept may still be validated, so their records stay in place.
+	state.AutoDNSValidation = types.BoolValue(false)
+	r.syncDCVRecords(ctx, &ssl.SSLOrder{ID: orderID, CommonName: commonName}, &state, &resp.Diagnostics)
+}
+
+// orderRefundable reports whether an order is still within the free refund period
+// of its product. A non-empty reason is returned when this cannot be determined.
+func (r *SSLOrderResource) orderRefundable(state SSLOrderModel, now time.Time) (bool, string) {
+	product, err := ssl.GetProduct(r.client, int(state.ProductID.ValueInt64()))
+	if err != nil {
+		return false, fmt.Sprintf("reading SSL product %d failed: %s", state.ProductID.ValueInt64(), err.Error())
+	}
+	if product.FreeRefundDays <= 0 {
+		return false, ""
+	}
+
+	ordered, err := parseDomainDate(state.OrderDate.ValueString())
+	if err != nil {
+		return false, fmt.Sprintf("the order date is unknown: %s", err.Error())
+	}
+	return !now.After(ordered.AddDate(0, 0, product.FreeRefundDays)), ""
 }
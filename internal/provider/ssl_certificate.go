@@ -0,0 +1,125 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseCertificatePEM parses the first certificate in PEM encoded data.
+func parseCertificatePEM(data string) (*x509.Certificate, error) {
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no PEM encoded certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// fullChainPEM joins a certificate and its CA bundle into a single PEM chain.
+func fullChainPEM(certificate, caBundle string) string {
+	certificate = strings.TrimSpace(certificate)
+	caBundle = strings.TrimSpace(caBundle)
+	if caBundle == "" {
+		return certificate + "\n"
+	}
+	return certificate + "\n" + caBundle + "\n"
+}
+
+// sslCertificate holds the issued certificate of an SSL order and the metadata
+// parsed from it, as shared by the SSL order resource and data sources.
+type sslCertificate struct {
+	CertificatePEM    types.String
+	CABundlePEM       types.String
+	FullChainPEM      types.String
+	SerialNumber      types.String
+	NotBefore         types.String
+	NotAfter          types.String
+	FingerprintSHA256 types.String
+	Issuer            types.String
+	SubjectAltNames   types.List
+}
+
+// mapCertificateToState maps the issued certificate of an SSL order and the
+// metadata parsed from it to the Terraform model. All attributes are null until
+// the certificate has been issued.
+func mapCertificateToState(ctx context.Context, order *ssl.SSLOrder, model *SSLOrderModel, diags *diag.Diagnostics) {
+	cert := certificateFromOrder(ctx, order, diags)
+	model.CertificatePEM = cert.CertificatePEM
+	model.CABundlePEM = cert.CABundlePEM
+	model.FullChainPEM = cert.FullChainPEM
+	model.SerialNumber = cert.SerialNumber
+	model.NotBefore = cert.NotBefore
+	model.NotAfter = cert.NotAfter
+	model.FingerprintSHA256 = cert.FingerprintSHA256
+	model.Issuer = cert.Issuer
+	model.SubjectAltNames = cert.SubjectAltNames
+}
+
+// certificateFromOrder returns the issued certificate of an SSL order and the
+// metadata parsed from it. All values are null until the certificate has been
+// issued.
+func certificateFromOrder(ctx context.Context, order *ssl.SSLOrder, diags *diag.Diagnostics) sslCertificate {
+	result := sslCertificate{
+		CertificatePEM:    types.StringNull(),
+		CABundlePEM:       types.StringNull(),
+		FullChainPEM:      types.StringNull(),
+		SerialNumber:      types.StringNull(),
+		NotBefore:         types.StringNull(),
+		NotAfter:          types.StringNull(),
+		FingerprintSHA256: types.StringNull(),
+		Issuer:            types.StringNull(),
+		SubjectAltNames:   types.ListNull(types.StringType),
+	}
+
+	if strings.TrimSpace(order.Certificate) == "" {
+		return result
+	}
+
+	result.CertificatePEM = types.StringValue(order.Certificate)
+	result.FullChainPEM = types.StringValue(fullChainPEM(order.Certificate, order.CertificateCA))
+	if strings.TrimSpace(order.CertificateCA) != "" {
+		result.CABundlePEM = types.StringValue(order.CertificateCA)
+	}
+
+	cert, err := parseCertificatePEM(order.Certificate)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Parse Certificate",
+			fmt.Sprintf("The certificate of SSL order %d could not be parsed, so its metadata is not available: %s", order.ID, err.Error()),
+		)
+		return result
+	}
+
+	fingerprint := sha256.Sum256(cert.Raw)
+	result.SerialNumber = types.StringValue(cert.SerialNumber.Text(16))
+	result.NotBefore = types.StringValue(cert.NotBefore.UTC().Format(time.RFC3339))
+	result.NotAfter = types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339))
+	result.FingerprintSHA256 = types.StringValue(hex.EncodeToString(fingerprint[:]))
+	result.Issuer = types.StringValue(cert.Issuer.String())
+
+	names := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses))
+	names = append(names, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		names = append(names, ip.String())
+	}
+	sans, listDiags := types.ListValueFrom(ctx, types.StringType, names)
+	diags.Append(listDiags...)
+	result.SubjectAltNames = sans
+	return result
+}
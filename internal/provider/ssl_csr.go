@@ -0,0 +1,149 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Key algorithms supported by the openprovider_ssl_csr resource.
+const (
+	keyAlgorithmRSA   = "RSA"
+	keyAlgorithmECDSA = "ECDSA"
+)
+
+// parseCSRPEM parses a PEM encoded certificate signing request and verifies its
+// signature.
+func parseCSRPEM(data string) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || (block.Type != "CERTIFICATE REQUEST" && block.Type != "NEW CERTIFICATE REQUEST") {
+		return nil, fmt.Errorf("no PEM encoded certificate request found")
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, fmt.Errorf("invalid signature: %w", err)
+	}
+	return csr, nil
+}
+
+// validateCSR checks that a certificate signing request is for the given common
+// name and additional domains. The CSR may repeat the common name in its SANs.
+func validateCSR(data string, commonName string, additionalDomains []string) error {
+	csr, err := parseCSRPEM(data)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(csr.Subject.CommonName, commonName) {
+		return fmt.Errorf("the CSR is for common name %q, but common_name is %q", csr.Subject.CommonName, commonName)
+	}
+
+	normalize := func(names []string) []string {
+		set := make(map[string]bool, len(names))
+		for _, name := range names {
+			name = strings.ToLower(name)
+			if name != strings.ToLower(commonName) {
+				set[name] = true
+			}
+		}
+		result := make([]string, 0, len(set))
+		for name := range set {
+			result = append(result, name)
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	csrNames, configuredNames := normalize(csr.DNSNames), normalize(additionalDomains)
+	if strings.Join(csrNames, ",") != strings.Join(configuredNames, ",") {
+		return fmt.Errorf("the CSR includes the additional domains %v, but additional_domains is %v", csrNames, configuredNames)
+	}
+	return nil
+}
+
+// csrOptions describes the key and subject of a generated certificate signing request.
+type csrOptions struct {
+	KeyAlgorithm       string
+	RSABits            int
+	ECDSACurve         string
+	CommonName         string
+	AdditionalDomains  []string
+	Organization       string
+	OrganizationalUnit string
+	Locality           string
+	Province           string
+	Country            string
+}
+
+// generateCSR generates a private key and a certificate signing request signed
+// with it, both PEM encoded. The common name is included in the SANs, as
+// required by browsers.
+func generateCSR(opts csrOptions) (string, string, error) {
+	var key crypto.Signer
+	var err error
+	switch opts.KeyAlgorithm {
+	case keyAlgorithmRSA:
+		key, err = rsa.GenerateKey(rand.Reader, opts.RSABits)
+	case keyAlgorithmECDSA:
+		var curve elliptic.Curve
+		switch opts.ECDSACurve {
+		case "P256":
+			curve = elliptic.P256()
+		case "P384":
+			curve = elliptic.P384()
+		default:
+			return "", "", fmt.Errorf("unsupported ECDSA curve %q", opts.ECDSACurve)
+		}
+		key, err = ecdsa.GenerateKey(curve, rand.Reader)
+	default:
+		return "", "", fmt.Errorf("unsupported key algorithm %q", opts.KeyAlgorithm)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	subject := pkix.Name{CommonName: opts.CommonName}
+	if opts.Organization != "" {
+		subject.Organization = []string{opts.Organization}
+	}
+	if opts.OrganizationalUnit != "" {
+		subject.OrganizationalUnit = []string{opts.OrganizationalUnit}
+	}
+	if opts.Locality != "" {
+		subject.Locality = []string{opts.Locality}
+	}
+	if opts.Province != "" {
+		subject.Province = []string{opts.Province}
+	}
+	if opts.Country != "" {
+		subject.Country = []string{opts.Country}
+	}
+
+	dnsNames := append([]string{opts.CommonName}, opts.AdditionalDomains...)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{Subject: subject, DNSNames: dnsNames}, key)
+	if err != nil {
+		return "", "", err
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+	return string(keyPEM), string(csrPEM), nil
+}
@@ -0,0 +1,299 @@
+// Package provider implements the Terraform provider for OpenProvider.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	dnslib "github.com/charpand/terraform-provider-openprovider/internal/client/dns"
+	"github.com/charpand/terraform-provider-openprovider/internal/client/ssl"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dcvRecordTTL is the TTL of DCV records published by auto_dns_validation. It is
+// kept short so that the certificate authority sees new records quickly.
+const dcvRecordTTL = 600
+
+// dcvRecordAttrTypes defines the attribute types for DCV records.
+var dcvRecordAttrTypes = map[string]attr.Type{
+	"domain":    types.StringType,
+	"type":      types.StringType,
+	"name":      types.StringType,
+	"value":     types.StringType,
+	"published": types.BoolType,
+}
+
+// DCVRecordModel describes a domain control validation record of an SSL order.
+type DCVRecordModel struct {
+	Domain    types.String `tfsdk:"domain"`
+	Type      types.String `tfsdk:"type"`
+	Name      types.String `tfsdk:"name"`
+	Value     types.String `tfsdk:"value"`
+	Published types.Bool   `tfsdk:"published"`
+}
+
+// dcvRecordKey identifies a DCV record by its type, name and value.
+func dcvRecordKey(record ssl.DCVRecord) string {
+	return strings.ToUpper(record.Type) + " " + strings.ToLower(strings.TrimSuffix(record.Name, ".")) + " " + record.Value
+}
+
+// mapDCVRecordsToState converts the DCV records of an order to the dcv_records
+// attribute. published holds the records the provider created in OpenProvider DNS.
+func mapDCVRecordsToState(ctx context.Context, records []ssl.DCVRecord, published map[string]ssl.DCVRecord, diags *diag.Diagnostics) types.List {
+	if len(records) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: dcvRecordAttrTypes})
+	}
+
+	stateRecords := make([]DCVRecordModel, 0, len(records))
+	for _, record := range records {
+		_, isPublished := published[dcvRecordKey(record)]
+		stateRecords = append(stateRecords, DCVRecordModel{
+			Domain:    types.StringValue(record.Domain),
+			Type:      types.StringValue(record.Type),
+			Name:      types.StringValue(record.Name),
+			Value:     types.StringValue(record.Value),
+			Published: types.BoolValue(isPublished),
+		})
+	}
+	listValue, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dcvRecordAttrTypes}, stateRecords)
+	diags.Append(listDiags...)
+	return listValue
+}
+
+// publishedDCVRecords returns the records of a dcv_records value that the provider
+// created in OpenProvider DNS.
+func publishedDCVRecords(ctx context.Context, list types.List, diags *diag.Diagnostics) map[string]ssl.DCVRecord {
+	published := make(map[string]ssl.DCVRecord)
+	if list.IsNull() || list.IsUnknown() {
+		return published
+	}
+
+	var records []DCVRecordModel
+	diags.Append(list.ElementsAs(ctx, &records, false)...)
+	for _, record := range records {
+		if !record.Published.ValueBool() {
+			continue
+		}
+		dcv := ssl.DCVRecord{
+			Domain: record.Domain.ValueString(),
+			Type:   record.Type.ValueString(),
+			Name:   record.Name.ValueString(),
+			Value:  record.Value.ValueString(),
+		}
+		published[dcvRecordKey(dcv)] = dcv
+	}
+	return published
+}
+
+// dcvZoneName returns the OpenProvider DNS zone that hosts the record name, or an
+// empty string when none does. The most specific zone wins.
+func dcvZoneName(zones []dnslib.Zone, name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+
+	best := ""
+	for _, zone := range zones {
+		zoneName := strings.ToLower(zone.Name + "." + zone.Extension)
+		if (name == zoneName || strings.HasSuffix(name, "."+zoneName)) && len(zoneName) > len(best) {
+			best = zoneName
+		}
+	}
+	return best
+}
+
+// relativeRecordName returns the record name relative to its zone, as used by the
+// DNS records API.
+func relativeRecordName(name, zoneName string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == zoneName {
+		return ""
+	}
+	return strings.TrimSuffix(name, "."+zoneName)
+}
+
+// dcvValidationPending reports whether an order with the given status still waits
+// for domain control validation.
+func dcvValidationPending(status string) bool {
+	return status != ssl.StatusActive && status != ssl.StatusExpired && issuanceFailureReason(status) == ""
+}
+
+// dcvSyncRequired reports whether the DCV records in a model differ from what
+// auto_dns_validation should have published: records of a pending order that are
+// not published, or published records of an order that is no longer pending.
+func dcvSyncRequired(ctx context.Context, model SSLOrderModel, diags *diag.Diagnostics) bool {
+	if model.DCVRecords.IsNull() || model.DCVRecords.IsUnknown() {
+		return false
+	}
+
+	var records []DCVRecordModel
+	diags.Append(model.DCVRecords.ElementsAs(ctx, &records, false)...)
+
+	publish := model.AutoDNSValidation.ValueBool() && dcvValidationPending(model.Status.ValueString())
+	for _, record := range records {
+		if record.Published.ValueBool() != publish {
+			return true
+		}
+	}
+	return false
+}
+
+// syncDCVRecords publishes the DCV records of a pending order in OpenProvider DNS
+// when auto_dns_validation is enabled, and removes them once the order is no longer
+// pending or auto_dns_validation is disabled. It sets dcv_records on the model. It
+// is only called when applying changes; Read reports drift through refreshDCVRecords.
+//
+// The order exists at this point, so DNS errors are reported as warnings to keep
+// it in state.
+func (r *SSLOrderResource) syncDCVRecords(ctx context.Context, order *ssl.SSLOrder, model *SSLOrderModel, diags *diag.Diagnostics) {
+	published := publishedDCVRecords(ctx, model.DCVRecords, diags)
+	pending := dcvValidationPending(order.Status)
+
+	var toPublish, toRemove []ssl.DCVRecord
+	if model.AutoDNSValidation.ValueBool() && pending {
+		for _, record := range order.DCVRecords {
+			if _, ok := published[dcvRecordKey(record)]; !ok {
+				toPublish = append(toPublish, record)
+			}
+		}
+	} else {
+		keys := make([]string, 0, len(published))
+		for key := range published {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			toRemove = append(toRemove, published[key])
+		}
+	}
+
+	if len(toPublish) > 0 || len(toRemove) > 0 {
+		zones, err := dnslib.ListZones(r.client)
+		if err != nil {
+			diags.AddWarning(
+				"Unable to Update DCV Records",
+				fmt.Sprintf("Could not list DNS zones to update the DCV records of %s: %s", order.CommonName, err.Error()),
+			)
+			toPublish, toRemove = nil, nil
+		}
+
+		for _, record := range toPublish {
+			zoneName := dcvZoneName(zones, record.Name)
+			if zoneName == "" {
+				diags.AddWarning(
+					"DCV Record Not Published",
+					fmt.Sprintf("No OpenProvider DNS zone hosts %s. Create the %s record with value %q to validate %s.",
+						record.Name, record.Type, record.Value, record.Domain),
+				)
+				continue
+			}
+
+			_, err := dnslib.CreateRecord(r.client, zoneName, &dnslib.CreateRecordRequest{
+				Name:  relativeRecordName(record.Name, zoneName),
+				Type:  record.Type,
+				Value: record.Value,
+				TTL:   dcvRecordTTL,
+			})
+			if err != nil {
+				diags.AddWarning(
+					"DCV Record Not Published",
+					fmt.Sprintf("Could not create the %s record %s in zone %s: %s", record.Type, record.Name, zoneName, err.Error()),
+				)
+				continue
+			}
+			published[dcvRecordKey(record)] = record
+		}
+
+		for _, record := range toRemove {
+			zoneName := dcvZoneName(zones, record.Name)
+			if zoneName != "" {
+				err := dnslib.DeleteRecord(r.client, zoneName, relativeRecordName(record.Name, zoneName), record.Type, record.Value)
+				if err != nil {
+					diags.AddWarning(
+						"DCV Record Not Removed",
+						fmt.Sprintf("Could not delete the %s record %s from zone %s: %s", record.Type, record.Name, zoneName, err.Error()),
+					)
+					continue
+				}
+			}
+			delete(published, dcvRecordKey(record))
+		}
+	}
+
+	model.DCVRecords = dcvRecordsState(ctx, order, published, diags)
+}
+
+// refreshDCVRecords sets dcv_records on the model from the DCV records of the order,
+// without changing OpenProvider DNS. Records the provider published are checked in
+// their zones, and are reported as no longer published when they were removed.
+func (r *SSLOrderResource) refreshDCVRecords(ctx context.Context, order *ssl.SSLOrder, model *SSLOrderModel, diags *diag.Diagnostics) {
+	published := publishedDCVRecords(ctx, model.DCVRecords, diags)
+
+	if len(published) > 0 {
+		zones, err := dnslib.ListZones(r.client)
+		if err != nil {
+			diags.AddWarning(
+				"Unable to Check DCV Records",
+				fmt.Sprintf("Could not list DNS zones to check the DCV records of %s: %s", order.CommonName, err.Error()),
+			)
+		} else {
+			zoneRecords := make(map[string][]dnslib.Record)
+			for key, record := range published {
+				zoneName := dcvZoneName(zones, record.Name)
+				if zoneName == "" {
+					delete(published, key)
+					continue
+				}
+
+				records, ok := zoneRecords[zoneName]
+				if !ok {
+					records, err = dnslib.ListRecords(r.client, zoneName)
+					if err != nil {
+						diags.AddWarning(
+							"Unable to Check DCV Records",
+							fmt.Sprintf("Could not list the records of zone %s to check the DCV records of %s: %s", zoneName, order.CommonName, err.Error()),
+						)
+						continue
+					}
+					zoneRecords[zoneName] = records
+				}
+
+				if !slices.ContainsFunc(records, func(existing dnslib.Record) bool {
+					return strings.EqualFold(existing.Type, record.Type) &&
+						strings.EqualFold(relativeRecordName(existing.Name, zoneName), relativeRecordName(record.Name, zoneName)) &&
+						existing.Value == record.Value
+				}) {
+					delete(published, key)
+				}
+			}
+		}
+	}
+
+	model.DCVRecords = dcvRecordsState(ctx, order, published, diags)
+}
+
+// dcvRecordsState converts the DCV records of an order to the dcv_records attribute.
+// Published records the order no longer reports are kept, so that they are still
+// removed later.
+func dcvRecordsState(ctx context.Context, order *ssl.SSLOrder, published map[string]ssl.DCVRecord, diags *diag.Diagnostics) types.List {
+	records := append([]ssl.DCVRecord(nil), order.DCVRecords...)
+	listed := make(map[string]bool, len(records))
+	for _, record := range records {
+		listed[dcvRecordKey(record)] = true
+	}
+	var unlisted []string
+	for key := range published {
+		if !listed[key] {
+			unlisted = append(unlisted, key)
+		}
+	}
+	sort.Strings(unlisted)
+	for _, key := range unlisted {
+		records = append(records, published[key])
+	}
+
+	return mapDCVRecordsToState(ctx, records, published, diags)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/charpand/terraform-provider-openprovider/internal/client/tlds"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTLDDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	d := NewTLDDataSource()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	expectedAttrs := []string{
+		"id", "name", "min_period", "max_period", "renew_available", "transfer_available",
+		"auth_code_required", "dnssec_supported", "idn_supported", "whois_privacy_supported",
+		"lock_supported", "owner_change_is_trade", "required_additional_data",
+	}
+	for _, attr := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Expected attribute %s not found in schema", attr)
+		}
+	}
+
+	if !resp.Schema.Attributes["name"].IsRequired() {
+		t.Error("name should be Required")
+	}
+}
+
+func TestTLDsDataSourceSchema(t *testing.T) {
+	ctx := context.Background()
+	d := NewTLDsDataSource()
+	resp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	for _, attr := range []string{"id", "names", "tlds"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("Expected attribute %s not found in schema", attr)
+		}
+	}
+}
+
+func TestValidateTLDCapabilities(t *testing.T) {
+	tld := &tlds.TLD{
+		Name:                   "us",
+		MinPeriod:              1,
+		MaxPeriod:              10,
+		TransferAvailable:      false,
+		DnssecAllowed:          false,
+		IsPrivateWhoisAllowed:  false,
+		IsLockable:             true,
+		RequiredAdditionalData: []string{"nexus_category"},
+	}
+	usData := types.ObjectValueMust(additionalDataAttrTypes, map[string]attr.Value{
+		"trustee_service":     types.BoolNull(),
+		"nexus_category":      types.StringValue("C11"),
+		"application_purpose": types.StringValue("P1"),
+		"legal_type":          types.StringNull(),
+		"entity_type":         types.StringNull(),
+		"id_number":           types.StringNull(),
+	})
+
+	testCases := []struct {
+		name        string
+		plan        DomainModel
+		isCreate    bool
+		expectError bool
+	}{
+		{
+			name:        "valid registration",
+			plan:        DomainModel{Period: types.Int64Value(2), AdditionalData: usData},
+			isCreate:    true,
+			expectError: false,
+		},
+		{
+			name:        "period above maximum",
+			plan:        DomainModel{Period: types.Int64Value(11), AdditionalData: usData},
+			isCreate:    true,
+			expectError: true,
+		},
+		{
+			name:        "missing required additional data",
+			plan:        DomainModel{Period: types.Int64Value(1)},
+			isCreate:    true,
+			expectError: true,
+		},
+		{
+			name:        "transfer not available",
+			plan:        DomainModel{AuthCode: types.StringValue("secret")},
+			isCreate:    true,
+			expectError: true,
+		},
+		{
+			name:        "whois privacy not allowed",
+			plan:        DomainModel{WhoisPrivacy: types.BoolValue(true)},
+			isCreate:    false,
+			expectError: true,
+		},
+		{
+			name:        "dnssec not supported",
+			plan:        DomainModel{IsDnssecEnabled: types.BoolValue(true)},
+			isCreate:    false,
+			expectError: true,
+		},
+		{
+			name:        "lock supported",
+			plan:        DomainModel{IsLocked: types.BoolValue(true)},
+			isCreate:    false,
+			expectError: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateTLDCapabilities(tc.plan, tc.isCreate, tld, &diags)
+
+			if diags.HasError() != tc.expectError {
+				t.Errorf("Expected error: %v, got diagnostics: %v", tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestDomainResourceModifyPlanUnsupportedTLD(t *testing.T) {
+	ctx := context.Background()
+	r := &DomainResource{client: newFakeAPIClient(t, fakeAPI{
+		"GET /v1beta/tlds/{name}": func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]any{"code": 404, "desc": "TLD not found"})
+		},
+	})}
+
+	config := resourceConfig(t, r, map[string]tftypes.Value{
+		"domain": tftypes.NewValue(tftypes.String, "example.invalid"),
+	})
+	state := tfsdk.State{Schema: config.Schema, Raw: tftypes.NewValue(config.Raw.Type(), nil)}
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Config: config, State: state, Plan: resp.Plan}, resp)
+
+	if !hasDiag(resp.Diagnostics, "Unsupported TLD") {
+		t.Errorf("Expected an Unsupported TLD error, got %v", resp.Diagnostics)
+	}
+}